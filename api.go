@@ -1,59 +1,79 @@
-package coolify_sdk
-
-import (
-	"encoding/json"
-
-	client "github.com/marconneves/coolify-sdk-go/client"
-)
-
-type ApiInstance struct {
-	client *client.Client
-}
-
-type EnableApiResponse struct {
-	Message string `json:"message"`
-}
-
-func (a *ApiInstance) Enable() (*string, error) {
-	body, err := a.client.HttpRequest("enable", "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	response := &EnableApiResponse{}
-	err = json.NewDecoder(body).Decode(response)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiEnabled string
-	if response.Message == "API Enabled." {
-		apiEnabled = "success"
-	} else {
-		apiEnabled = "failure"
-	}
-
-	return &apiEnabled, nil
-}
-
-func (a *ApiInstance) Disable() (*string, error) {
-	body, err := a.client.HttpRequest("disable", "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	response := &EnableApiResponse{}
-	err = json.NewDecoder(body).Decode(response)
-	if err != nil {
-		return nil, err
-	}
-
-	var apiDisabled string
-	if response.Message == "API disabled." {
-		apiDisabled = "success"
-	} else {
-		apiDisabled = "failure"
-	}
-
-	return &apiDisabled, nil
-}
+package coolify_sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+)
+
+type ApiInstance struct {
+	client *client.Client
+}
+
+// ApiError is returned by Enable and Disable when the API state could not be
+// changed. It wraps the underlying error, a *client.StatusError when Coolify
+// refused the request.
+type ApiError struct {
+	// Action is "enable" or "disable".
+	Action string
+	Err    error
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("failed to %s the API: %v", e.Action, e.Err)
+}
+
+func (e *ApiError) Unwrap() error {
+	return e.Err
+}
+
+// Enable enables the Coolify API.
+// Deprecated: Use EnableWithContext instead.
+func (a *ApiInstance) Enable() error {
+	return a.EnableWithContext(context.Background())
+}
+
+// EnableWithContext enables the Coolify API.
+func (a *ApiInstance) EnableWithContext(ctx context.Context) error {
+	return a.toggle(ctx, "enable")
+}
+
+// Disable disables the Coolify API.
+// Deprecated: Use DisableWithContext instead.
+func (a *ApiInstance) Disable() error {
+	return a.DisableWithContext(context.Background())
+}
+
+// DisableWithContext disables the Coolify API.
+func (a *ApiInstance) DisableWithContext(ctx context.Context) error {
+	return a.toggle(ctx, "disable")
+}
+
+func (a *ApiInstance) toggle(ctx context.Context, action string) error {
+	body, err := a.client.HttpRequestWithContext(ctx, action, "GET")
+	if err != nil {
+		return &ApiError{Action: action, Err: err}
+	}
+	body.Close()
+
+	return nil
+}
+
+// Status reports whether the Coolify API is enabled. Coolify has no endpoint
+// for it, so it is inferred from whether the API accepts the token. The IP
+// allowlist of the API is not exposed by Coolify either. The request
+// bypasses the client's cache.
+func (a *ApiInstance) Status(ctx context.Context) (bool, error) {
+	body, err := a.client.Probe(ctx, "version")
+	if client.StatusCode(err) == http.StatusForbidden {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	body.Close()
+
+	return true, nil
+}
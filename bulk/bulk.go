@@ -0,0 +1,125 @@
+// Package bulk runs an SDK operation across many resources with bounded
+// concurrency and aggregated errors.
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// DefaultConcurrency is the number of operations run at once when
+// Options.Concurrency is zero.
+const DefaultConcurrency = 4
+
+// ErrSkipped is the error of items that were not started because an earlier
+// item failed in FailFast mode.
+var ErrSkipped = errors.New("skipped after an earlier failure")
+
+// Mode decides what Run does when an item fails.
+type Mode int
+
+const (
+	// BestEffort runs every item regardless of failures.
+	BestEffort Mode = iota
+	// FailFast cancels the items in flight and skips the remaining ones
+	// after the first failure.
+	FailFast
+)
+
+// Options configures Run.
+type Options struct {
+	Concurrency int
+	Mode        Mode
+}
+
+// Func is the operation run for each UUID.
+type Func func(ctx context.Context, uuid string) error
+
+// Result is the outcome of the operation for one UUID.
+type Result struct {
+	UUID string
+	Err  error
+}
+
+// Results holds one Result per UUID, in the order the UUIDs were given.
+type Results []Result
+
+// Failed returns the UUIDs whose operation failed or did not run.
+func (r Results) Failed() []string {
+	var uuids []string
+	for _, result := range r {
+		if result.Err != nil {
+			uuids = append(uuids, result.UUID)
+		}
+	}
+	return uuids
+}
+
+// Err joins the errors of the failed items, each prefixed with its UUID.
+// Skipped items are left out. It returns nil when every item succeeded.
+func (r Results) Err() error {
+	var errs []error
+	for _, result := range r {
+		if result.Err != nil && !errors.Is(result.Err, ErrSkipped) {
+			errs = append(errs, fmt.Errorf("%s: %w", result.UUID, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run calls fn for every UUID, at most opts.Concurrency at a time, and
+// returns the per-item results along with Results.Err. Items not started
+// when ctx is done fail with the context's error.
+func Run(ctx context.Context, uuids []string, fn Func, opts Options) (Results, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(Results, len(uuids))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, uuid := range uuids {
+		results[i].UUID = uuid
+
+		select {
+		case slots <- struct{}{}:
+		case <-runCtx.Done():
+			results[i].Err = skipped(ctx)
+			continue
+		}
+		if runCtx.Err() != nil {
+			<-slots
+			results[i].Err = skipped(ctx)
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			err := fn(runCtx, uuid)
+			results[i].Err = err
+			if err != nil && opts.Mode == FailFast {
+				cancel()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return results, results.Err()
+}
+
+func skipped(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return ErrSkipped
+}
@@ -0,0 +1,41 @@
+package bulk
+
+import (
+	"context"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/database"
+)
+
+// DatabaseUUIDs returns the UUIDs of the databases matching filter, for
+// example every database with a given tag.
+func DatabaseUUIDs(ctx context.Context, databases coolify_sdk.DatabaseAPI, filter database.Filter) ([]string, error) {
+	var uuids []string
+	for db, err := range databases.All(ctx, filter) {
+		if err != nil {
+			return nil, err
+		}
+		uuids = append(uuids, db.UUID)
+	}
+	return uuids, nil
+}
+
+// StartDatabases starts every database in uuids.
+func StartDatabases(ctx context.Context, databases coolify_sdk.DatabaseAPI, uuids []string, opts Options) (Results, error) {
+	return Run(ctx, uuids, databases.Start, opts)
+}
+
+// StopDatabases stops every database in uuids.
+func StopDatabases(ctx context.Context, databases coolify_sdk.DatabaseAPI, uuids []string, opts Options) (Results, error) {
+	return Run(ctx, uuids, databases.Stop, opts)
+}
+
+// RestartDatabases restarts every database in uuids.
+func RestartDatabases(ctx context.Context, databases coolify_sdk.DatabaseAPI, uuids []string, opts Options) (Results, error) {
+	return Run(ctx, uuids, databases.Restart, opts)
+}
+
+// ValidateServers validates every server in uuids.
+func ValidateServers(ctx context.Context, servers coolify_sdk.ServerAPI, uuids []string, opts Options) (Results, error) {
+	return Run(ctx, uuids, servers.ValidateWithContext, opts)
+}
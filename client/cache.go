@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheOptions configures a Cache.
+type CacheOptions struct {
+	// TTL applies to resources missing from Resources. Zero disables
+	// caching for them.
+	TTL time.Duration
+	// Resources sets the TTL per resource, the first segment of the request
+	// path such as "servers", "projects", "teams" or "security".
+	Resources map[string]time.Duration
+	// LoadTimeout bounds a request shared by concurrent GETs, which is not
+	// canceled with the context of the caller that started it. Defaults to
+	// DefaultCacheLoadTimeout.
+	LoadTimeout time.Duration
+}
+
+// DefaultCacheLoadTimeout is the LoadTimeout used when it is zero.
+const DefaultCacheLoadTimeout = 30 * time.Second
+
+// Cache keeps the responses of GET requests for a while. Concurrent
+// identical GETs share a single request, and a successful POST, PATCH or
+// DELETE drops the cached responses of the resource it touches. GETs that
+// trigger actions, such as databases/{uuid}/start, are never cached and
+// invalidate like mutations; enabling or disabling the API drops everything.
+type Cache struct {
+	opts CacheOptions
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	calls   map[string]*cacheCall
+	// generations and epoch change on invalidation so that responses loaded
+	// before it are not stored.
+	generations map[string]int
+	epoch       int
+}
+
+type cacheEntry struct {
+	resource string
+	data     []byte
+	expires  time.Time
+}
+
+type cacheCall struct {
+	resource string
+	done     chan struct{}
+	data     []byte
+	err      error
+}
+
+// actions are the last path segments of GET endpoints that change state.
+var actions = map[string]bool{
+	"start":    true,
+	"stop":     true,
+	"restart":  true,
+	"validate": true,
+	"test":     true,
+	"enable":   true,
+	"disable":  true,
+	"deploy":   true,
+}
+
+// purges are the endpoints whose success drops every cached response.
+var purges = map[string]bool{
+	"enable":  true,
+	"disable": true,
+}
+
+// NewCache returns an empty cache.
+func NewCache(opts CacheOptions) *Cache {
+	return &Cache{
+		opts:        opts,
+		entries:     map[string]cacheEntry{},
+		calls:       map[string]*cacheCall{},
+		generations: map[string]int{},
+	}
+}
+
+// WithCache caches the client's GET responses in cache.
+func WithCache(cache *Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// Invalidate drops the cached responses of the resource requestPath
+// belongs to.
+func (c *Cache) Invalidate(requestPath string) {
+	resource := cacheResource(requestPath)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[resource]++
+	for key, entry := range c.entries {
+		if entry.resource == resource {
+			delete(c.entries, key)
+		}
+	}
+	// Later requests must not join loads started before the invalidation.
+	for key, call := range c.calls {
+		if call.resource == resource {
+			delete(c.calls, key)
+		}
+	}
+}
+
+// Purge drops every cached response.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.epoch++
+	clear(c.entries)
+	clear(c.calls)
+}
+
+// isAction reports whether requestPath is a GET endpoint that changes state.
+func isAction(requestPath string) bool {
+	return actions[path.Base(requestPath)]
+}
+
+// cacheable reports whether a request may be served from the cache.
+func (c *Cache) cacheable(method, requestPath string) bool {
+	return method == http.MethodGet && !isAction(requestPath) && c.ttl(requestPath) > 0
+}
+
+// invalidates reports whether a successful request drops cached responses.
+func (c *Cache) invalidates(method, requestPath string) bool {
+	return method != http.MethodGet || isAction(requestPath)
+}
+
+func (c *Cache) ttl(requestPath string) time.Duration {
+	if ttl, ok := c.opts.Resources[cacheResource(requestPath)]; ok {
+		return ttl
+	}
+	return c.opts.TTL
+}
+
+// fetch returns the cached response for the request, or calls load once for
+// all concurrent identical requests and caches its result. load runs with a
+// context that is detached from the callers' and bounded by LoadTimeout, so
+// that a caller giving up does not fail the others; each caller still
+// returns as soon as its own ctx is done.
+func (c *Cache) fetch(ctx context.Context, method, requestPath string, load func(context.Context) ([]byte, error)) ([]byte, error) {
+	key := method + " " + requestPath
+	resource := cacheResource(requestPath)
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok && time.Now().Before(entry.expires) {
+		c.mu.Unlock()
+		return entry.data, nil
+	}
+	call, ok := c.calls[key]
+	if !ok {
+		call = &cacheCall{resource: resource, done: make(chan struct{})}
+		c.calls[key] = call
+		go c.load(ctx, key, resource, requestPath, call, load)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.data, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// load runs a shared request and stores its result.
+func (c *Cache) load(ctx context.Context, key, resource, requestPath string, call *cacheCall, load func(context.Context) ([]byte, error)) {
+	c.mu.Lock()
+	generation, epoch := c.generations[resource], c.epoch
+	c.mu.Unlock()
+
+	timeout := c.opts.LoadTimeout
+	if timeout <= 0 {
+		timeout = DefaultCacheLoadTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	call.data, call.err = load(ctx)
+
+	c.mu.Lock()
+	if c.calls[key] == call {
+		delete(c.calls, key)
+	}
+	if call.err == nil && c.generations[resource] == generation && c.epoch == epoch {
+		c.entries[key] = cacheEntry{resource: resource, data: call.data, expires: time.Now().Add(c.ttl(requestPath))}
+	}
+	c.mu.Unlock()
+	close(call.done)
+}
+
+func cacheResource(requestPath string) string {
+	resource, _, _ := strings.Cut(strings.TrimPrefix(requestPath, "/"), "/")
+	resource, _, _ = strings.Cut(resource, "?")
+	return resource
+}
@@ -1,100 +1,269 @@
-package client
-
-import (
-	"bytes"
-	"context"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
-)
-
-type Client struct {
-	hostname   string
-	apiToken   string
-	httpClient *http.Client
-}
-
-func NewClient(hostname string, apiToken string) *Client {
-	client := &Client{
-		hostname:   hostname,
-		apiToken:   apiToken,
-		httpClient: &http.Client{},
-	}
-
-	return client
-}
-
-// HttpRequest performs an HTTP request.
-// Deprecated: Use HttpRequestWithContext instead.
-func (client *Client) HttpRequest(path, method string, body ...bytes.Buffer) (closer io.ReadCloser, err error) {
-	return client.HttpRequestWithContext(context.Background(), path, method, body...)
-}
-
-// HttpRequestWithContext performs an HTTP request with context support.
-func (client *Client) HttpRequestWithContext(ctx context.Context, path, method string, body ...bytes.Buffer) (closer io.ReadCloser, err error) {
-	url := client.requestPath(path)
-	var bodyBuffer bytes.Buffer
-
-	if len(body) > 0 {
-		bodyBuffer = body[0]
-	}
-
-	req, err := http.NewRequestWithContext(ctx, method, url, &bodyBuffer)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Add("Authorization", "Bearer "+client.apiToken)
-	if bodyBuffer.Len() > 0 {
-		req.Header.Add("Content-Type", "application/json")
-	}
-
-	resp, err := client.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to perform request: %w", err)
-	}
-
-	if resp.StatusCode == http.StatusUnauthorized {
-		return nil, fmt.Errorf("unauthenticated")
-	}
-
-	if resp.StatusCode == http.StatusBadRequest {
-		return nil, fmt.Errorf("invalid token")
-	}
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		respBody := new(bytes.Buffer)
-		_, err := respBody.ReadFrom(resp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("got a non 200 status code: %v", resp.StatusCode)
-		}
-		return nil, fmt.Errorf("got a non 200 status code: %v - %s", resp.StatusCode, respBody.String())
-	}
-
-	return resp.Body, nil
-}
-
-func (c *Client) requestPath(path string) string {
-	return c.hostname + "/api/v1/" + path
-}
-
-func DecodeResponse[T any](body io.ReadCloser, target *T) (*T, error) {
-	err := json.NewDecoder(body).Decode(target)
-	if err != nil {
-		return nil, err
-	}
-
-	return target, nil
-}
-
-func EncodeRequest[T any](target *T) (*bytes.Buffer, error) {
-	buf := &bytes.Buffer{}
-
-	err := json.NewEncoder(buf).Encode(target)
-	if err != nil {
-		return nil, err
-	}
-
-	return buf, nil
-}
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type Client struct {
+	hostname   string
+	apiToken   string
+	httpClient *http.Client
+	transport  http.RoundTripper
+	observer   Observer
+	maxRetries int
+	retryDelay time.Duration
+	limiter    *RateLimiter
+	cache      *Cache
+	version    *versionCache
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient replaces the underlying *http.Client.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the http.RoundTripper used by the underlying *http.Client.
+// It applies to a copy, so a client passed to WithHTTPClient is not modified,
+// whichever option comes first.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.transport = transport
+	}
+}
+
+// WithObserver registers an Observer notified around every request.
+func WithObserver(observer Observer) Option {
+	return func(c *Client) {
+		c.observer = observer
+	}
+}
+
+// WithRetries retries GET requests failing with a transport error, 429 or 5xx
+// up to max times, waiting delay multiplied by the attempt number in between,
+// or longer when the response carries a Retry-After header. Action endpoints
+// such as databases/{uuid}/start are only retried on 429, since a timeout or
+// 5xx may come after the action already ran.
+func WithRetries(max int, delay time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = max
+		c.retryDelay = delay
+	}
+}
+
+func NewClient(hostname string, apiToken string, opts ...Option) *Client {
+	client := &Client{
+		hostname:   hostname,
+		apiToken:   apiToken,
+		httpClient: &http.Client{},
+		version:    &versionCache{},
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	if client.transport != nil {
+		httpClient := *client.httpClient
+		httpClient.Transport = client.transport
+		client.httpClient = &httpClient
+	}
+
+	return client
+}
+
+// HttpRequest performs an HTTP request.
+// Deprecated: Use HttpRequestWithContext instead.
+func (client *Client) HttpRequest(path, method string, body ...bytes.Buffer) (closer io.ReadCloser, err error) {
+	return client.HttpRequestWithContext(context.Background(), path, method, body...)
+}
+
+// HttpRequestWithContext performs an HTTP request with context support.
+func (client *Client) HttpRequestWithContext(ctx context.Context, path, method string, body ...bytes.Buffer) (io.ReadCloser, error) {
+	ctx = client.withOperation(ctx)
+
+	var payload []byte
+	if len(body) > 0 {
+		payload = body[0].Bytes()
+	}
+
+	if client.cache == nil {
+		return client.send(ctx, path, method, payload)
+	}
+
+	if client.cache.cacheable(method, path) {
+		data, err := client.cache.fetch(ctx, method, path, func(ctx context.Context) ([]byte, error) {
+			body, err := client.send(ctx, path, method, payload)
+			if err != nil {
+				return nil, err
+			}
+			defer body.Close()
+			return io.ReadAll(body)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	closer, err := client.send(ctx, path, method, payload)
+	if err == nil && purges[path] {
+		client.cache.Purge()
+	} else if err == nil && client.cache.invalidates(method, path) {
+		client.cache.Invalidate(path)
+	}
+	return closer, err
+}
+
+// Probe performs a single GET that bypasses the cache and is never retried,
+// for checks that must report the current state of the instance.
+func (client *Client) Probe(ctx context.Context, path string) (io.ReadCloser, error) {
+	return client.perform(client.withOperation(ctx), path, http.MethodGet, nil, false)
+}
+
+// send performs a request, waiting for the rate limiter and retrying as
+// configured.
+func (client *Client) send(ctx context.Context, path, method string, payload []byte) (io.ReadCloser, error) {
+	return client.perform(ctx, path, method, payload, true)
+}
+
+// perform performs a request, retrying it as configured when retry is set.
+func (client *Client) perform(ctx context.Context, path, method string, payload []byte, retry bool) (closer io.ReadCloser, err error) {
+	result := Result{}
+	if client.observer != nil {
+		var finish func(Result)
+		ctx, finish = client.observer.Start(ctx, Request{Method: method, Path: path, Operation: operationOf(ctx)})
+		defer func() {
+			result.Err = err
+			finish(result)
+		}()
+	}
+
+	for {
+		if client.limiter != nil {
+			if err = client.limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("failed to perform request: %w", err)
+			}
+		}
+
+		var resp *http.Response
+		resp, err = client.do(ctx, path, method, payload)
+		if resp != nil {
+			result.StatusCode = resp.StatusCode
+			if client.limiter != nil {
+				client.limiter.Observe(resp)
+			}
+		}
+
+		if !retry || !client.shouldRetry(method, path, resp, err, result.Retries) {
+			if err != nil {
+				return nil, err
+			}
+			return client.checkResponse(resp)
+		}
+
+		result.Retries++
+		delay := client.retryDelay * time.Duration(result.Retries)
+		if resp != nil {
+			resp.Body.Close()
+			if retryAfter, ok := RetryAfter(resp, time.Now()); ok && retryAfter > delay {
+				delay = retryAfter
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to perform request: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (client *Client) do(ctx context.Context, path, method string, payload []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, client.requestPath(path), bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Add("Authorization", "Bearer "+client.apiToken)
+	if len(payload) > 0 {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform request: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (client *Client) shouldRetry(method, path string, resp *http.Response, err error, retries int) bool {
+	if method != http.MethodGet || retries >= client.maxRetries {
+		return false
+	}
+
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if isAction(path) {
+		return false
+	}
+
+	return err != nil || resp.StatusCode >= http.StatusInternalServerError
+}
+
+func (client *Client) checkResponse(resp *http.Response) (io.ReadCloser, error) {
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		return resp.Body, nil
+	}
+
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, newStatusError(resp.StatusCode, nil)
+	}
+	return nil, newStatusError(resp.StatusCode, respBody)
+}
+
+func (c *Client) requestPath(path string) string {
+	return c.hostname + "/api/v1/" + path
+}
+
+// DecodeResponse reads and closes body and decodes it into target with
+// Unmarshal.
+func DecodeResponse[T any](body io.ReadCloser, target *T) (*T, error) {
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Unmarshal(data, target); err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
+
+func EncodeRequest[T any](target *T) (*bytes.Buffer, error) {
+	buf := &bytes.Buffer{}
+
+	err := json.NewEncoder(buf).Encode(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
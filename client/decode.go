@@ -0,0 +1,279 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExtraField is the name of the struct field that collects unknown JSON
+// keys. It must be a map[string]json.RawMessage tagged `json:"-"`.
+const ExtraField = "Extra"
+
+// timestampLayouts are the formats Coolify and Laravel use for dates.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02T15:04:05.999999",
+	"2006-01-02",
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	rawMessageType  = reflect.TypeOf(json.RawMessage{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// Unmarshal decodes JSON into v, tolerating the quirks of PHP and Laravel
+// serialization:
+//
+//   - empty arrays where an object is expected ("proxy": []) decode as
+//     null or an empty object,
+//   - numeric strings ("1") decode into ints and floats, and "1"/"0",
+//     "true"/"false" and 1/0 into bools,
+//   - numbers decode into strings,
+//   - timestamps are accepted in RFC 3339, "2006-01-02 15:04:05" and
+//     date-only form, and empty strings decode as the zero time.
+//
+// Keys that match no field are stored in the struct's Extra field when it
+// has one.
+func Unmarshal(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return err
+	}
+
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return json.Unmarshal(data, v)
+	}
+
+	generic = normalize(generic, target.Type().Elem())
+
+	normalized, err := json.Marshal(generic)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(normalized, v); err != nil {
+		return err
+	}
+
+	captureExtra(generic, target.Elem())
+	return nil
+}
+
+// normalize rewrites a generic JSON value so that encoding/json can decode
+// it into t.
+func normalize(value any, t reflect.Type) any {
+	if value == nil {
+		return nil
+	}
+
+	pointer := false
+	for t.Kind() == reflect.Pointer {
+		pointer = true
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return normalizeTime(value)
+	}
+	if t == rawMessageType || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return value
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]any)
+		if !ok {
+			if isEmptyArray(value) {
+				if pointer {
+					return nil
+				}
+				return map[string]any{}
+			}
+			return value
+		}
+
+		fields := jsonFields(t)
+		for key, item := range object {
+			if field, ok := fields[strings.ToLower(key)]; ok {
+				object[key] = normalize(item, field.Type)
+			}
+		}
+		return object
+
+	case reflect.Map:
+		if isEmptyArray(value) {
+			return map[string]any{}
+		}
+		if object, ok := value.(map[string]any); ok {
+			for key, item := range object {
+				object[key] = normalize(item, t.Elem())
+			}
+		}
+		return value
+
+	case reflect.Slice, reflect.Array:
+		if items, ok := value.([]any); ok {
+			for i, item := range items {
+				items[i] = normalize(item, t.Elem())
+			}
+		}
+		return value
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		switch v := value.(type) {
+		case string:
+			trimmed := strings.TrimSpace(v)
+			if trimmed == "" {
+				return nil
+			}
+			if _, err := strconv.ParseFloat(trimmed, 64); err == nil {
+				return json.Number(trimmed)
+			}
+		case bool:
+			if v {
+				return json.Number("1")
+			}
+			return json.Number("0")
+		}
+		return value
+
+	case reflect.Bool:
+		switch v := value.(type) {
+		case string:
+			if parsed, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return parsed
+			}
+			if v == "" {
+				return false
+			}
+		case json.Number:
+			if n, err := v.Float64(); err == nil {
+				return n != 0
+			}
+		}
+		return value
+
+	case reflect.String:
+		if v, ok := value.(json.Number); ok {
+			return v.String()
+		}
+		return value
+	}
+
+	return value
+}
+
+func normalizeTime(value any) any {
+	switch v := value.(type) {
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil
+		}
+		for _, layout := range timestampLayouts {
+			if parsed, err := time.Parse(layout, v); err == nil {
+				return parsed.Format(time.RFC3339Nano)
+			}
+		}
+	case json.Number:
+		if seconds, err := v.Int64(); err == nil {
+			return time.Unix(seconds, 0).UTC().Format(time.RFC3339Nano)
+		}
+	}
+	return value
+}
+
+// captureExtra stores the keys of each decoded object that match no field
+// in the struct's Extra map.
+func captureExtra(value any, target reflect.Value) {
+	if value == nil {
+		return
+	}
+
+	for target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			return
+		}
+		target = target.Elem()
+	}
+
+	switch target.Kind() {
+	case reflect.Struct:
+		object, ok := value.(map[string]any)
+		if !ok || target.Type() == timeType {
+			return
+		}
+
+		fields := jsonFields(target.Type())
+		extra := map[string]json.RawMessage{}
+		for key, item := range object {
+			field, ok := fields[strings.ToLower(key)]
+			if !ok {
+				if raw, err := json.Marshal(item); err == nil {
+					extra[key] = raw
+				}
+				continue
+			}
+			if child, err := target.FieldByIndexErr(field.Index); err == nil {
+				captureExtra(item, child)
+			}
+		}
+
+		if len(extra) == 0 {
+			return
+		}
+		if field := target.FieldByName(ExtraField); field.IsValid() && field.CanSet() && field.Type() == reflect.TypeOf(extra) {
+			field.Set(reflect.ValueOf(extra))
+		}
+
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]any)
+		if !ok {
+			return
+		}
+		for i := 0; i < len(items) && i < target.Len(); i++ {
+			captureExtra(items[i], target.Index(i))
+		}
+	}
+}
+
+// jsonFields maps the lower-cased JSON names of a struct's fields, including
+// promoted ones, to the fields.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = field
+	}
+	return fields
+}
+
+func isEmptyArray(value any) bool {
+	items, ok := value.([]any)
+	return ok && len(items) == 0
+}
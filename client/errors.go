@@ -0,0 +1,51 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// StatusError is returned for responses with an unexpected HTTP status.
+type StatusError struct {
+	StatusCode int
+	// Message is the "message" field of the response body, when present.
+	Message string
+	Body    string
+}
+
+func newStatusError(statusCode int, body []byte) *StatusError {
+	err := &StatusError{StatusCode: statusCode, Body: string(body)}
+
+	var response struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &response) == nil {
+		err.Message = response.Message
+	}
+	return err
+}
+
+func (e *StatusError) Error() string {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return "unauthenticated"
+	case http.StatusBadRequest:
+		return "invalid token"
+	}
+
+	if e.Body == "" {
+		return fmt.Sprintf("got a non 200 status code: %v", e.StatusCode)
+	}
+	return fmt.Sprintf("got a non 200 status code: %v - %s", e.StatusCode, e.Body)
+}
+
+// StatusCode returns the HTTP status of a StatusError in err's chain, or 0.
+func StatusCode(err error) int {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+	return 0
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// page is a Laravel paginated response.
+type page[T any] struct {
+	Data        []T `json:"data"`
+	CurrentPage int `json:"current_page"`
+	LastPage    int `json:"last_page"`
+}
+
+// Iterate yields the items of a list endpoint. A JSON array is a single
+// page; Laravel paginated responses ({"data": [...], "current_page": n,
+// "last_page": m}) are followed page by page. keep, when non-nil, filters
+// items on the client. Iteration stops after the first error.
+func Iterate[T any](ctx context.Context, c *Client, path string, keep func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		for number := 1; ; number++ {
+			pagePath := path
+			if number > 1 {
+				separator := "?"
+				if strings.Contains(path, "?") {
+					separator = "&"
+				}
+				pagePath = fmt.Sprintf("%s%spage=%d", path, separator, number)
+			}
+
+			items, last, err := fetchPage[T](ctx, c, pagePath)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if keep != nil && !keep(item) {
+					continue
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if last {
+				return
+			}
+		}
+	}
+}
+
+// fetchPage requests one page and reports whether it is the last one.
+func fetchPage[T any](ctx context.Context, c *Client, path string) ([]T, bool, error) {
+	body, err := c.HttpRequestWithContext(ctx, path, "GET")
+	if err != nil {
+		return nil, false, err
+	}
+	defer body.Close()
+
+	raw, err := DecodeResponse(body, &json.RawMessage{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if trimmed := bytes.TrimSpace(*raw); len(trimmed) > 0 && trimmed[0] == '[' {
+		var items []T
+		if err := Unmarshal(trimmed, &items); err != nil {
+			return nil, false, err
+		}
+		return items, true, nil
+	}
+
+	var p page[T]
+	if err := Unmarshal(*raw, &p); err != nil {
+		return nil, false, err
+	}
+	return p.Data, len(p.Data) == 0 || p.CurrentPage >= p.LastPage, nil
+}
@@ -0,0 +1,27 @@
+package client
+
+import "context"
+
+// Request describes an API call as seen by an Observer.
+type Request struct {
+	Method string
+	Path   string
+	// Operation names the SDK method making the request, such as
+	// "database.CreatePostgreSQL", or is "request" when the Client is used
+	// directly.
+	Operation string
+}
+
+// Result describes the outcome of an API call once all retries are done.
+type Result struct {
+	StatusCode int
+	Retries    int
+	Err        error
+}
+
+// Observer is notified before and after every API call made by a Client.
+// Start may return a derived context, which is used for the outgoing
+// requests, and must return a function called with the final Result.
+type Observer interface {
+	Start(ctx context.Context, req Request) (context.Context, func(Result))
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+const modulePath = "github.com/marconneves/coolify-sdk-go"
+
+type operationKey struct{}
+
+// withOperation records the operation of the requests made with ctx, unless
+// one is recorded already, so that requests run on other goroutines, such as
+// shared cache loads, keep the name of the method that started them.
+func (client *Client) withOperation(ctx context.Context) context.Context {
+	if client.observer == nil || ctx.Value(operationKey{}) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, operationKey{}, operation())
+}
+
+// operationOf returns the operation recorded by withOperation.
+func operationOf(ctx context.Context) string {
+	if name, ok := ctx.Value(operationKey{}).(string); ok {
+		return name
+	}
+	return "request"
+}
+
+// operation names the SDK method making a request, such as
+// "database.CreatePostgreSQL", from the innermost exported method of the SDK
+// on the call stack. It returns "request" when there is none, for instance
+// when the Client is used directly.
+func operation() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if name, ok := operationName(frame.Function); ok {
+			return name
+		}
+		if !more {
+			return "request"
+		}
+	}
+}
+
+// operationName turns a function name reported by the runtime, such as
+// "github.com/marconneves/coolify-sdk-go.(*ProjectInstance).GetWithContext",
+// into an operation name, "project.Get". Functions outside the SDK, in this
+// package, in tests or unexported are rejected.
+func operationName(function string) (string, bool) {
+	rest, ok := strings.CutPrefix(function, modulePath)
+	if !ok || rest == "" {
+		return "", false
+	}
+
+	pkg := "sdk"
+	switch rest[0] {
+	case '/':
+		path, symbol, found := strings.Cut(rest[1:], ".")
+		if !found {
+			return "", false
+		}
+		pkg, rest = path[strings.LastIndexByte(path, '/')+1:], symbol
+	case '.':
+		rest = rest[1:]
+	default:
+		return "", false
+	}
+	if pkg == "client" || pkg == "tests" || strings.HasSuffix(pkg, "_test") {
+		return "", false
+	}
+
+	// Drop closures ("Method.func1") and type parameters ("Func[...]").
+	receiver, method := "", rest
+	if strings.HasPrefix(rest, "(") {
+		receiver, method, _ = strings.Cut(rest, ").")
+		receiver = strings.TrimLeft(receiver, "(*")
+	}
+	method, _, _ = strings.Cut(method, ".")
+	method, _, _ = strings.Cut(method, "[")
+	if method == "" || !unicode.IsUpper(rune(method[0])) {
+		return "", false
+	}
+
+	if name, ok := strings.CutSuffix(receiver, "Instance"); ok {
+		pkg = snakeCase(strings.ReplaceAll(name, "GitHub", "Github"))
+	}
+	return pkg + "." + strings.TrimSuffix(method, "WithContext"), true
+}
+
+// snakeCase converts a Go identifier such as S3Storage to s3_storage.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := rune(name[i-1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
@@ -0,0 +1,20 @@
+package client
+
+// Deref returns the value p points to, or the zero value when p is nil.
+func Deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// Optional returns a pointer to v, or nil when v is the zero value so that
+// the field is omitted and Coolify applies its default.
+func Optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket that paces the requests of a Client. It also
+// follows the throttling headers sent by Coolify: X-RateLimit-Remaining caps
+// the tokens available and Retry-After pauses every request until it passes.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	// last is when tokens was last refilled. It is in the future while a
+	// Retry-After pause is in effect.
+	last time.Time
+}
+
+// NewRateLimiter returns a limiter allowing perSecond requests on average
+// and up to burst at once. With a perSecond of zero it only follows the
+// throttling headers.
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// WithRateLimit limits the client to perSecond requests on average and up
+// to burst at once. Every resource of an Sdk shares its client and so the
+// same limit.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return WithRateLimiter(NewRateLimiter(perSecond, burst))
+}
+
+// WithRateLimiter uses limiter for the client's requests. Passing the same
+// limiter to several clients makes them share the limit, for example when
+// they use the same token.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.refill(now)
+	l.tokens--
+	delay := l.last.Sub(now)
+	if l.tokens < 0 && l.rate > 0 {
+		delay += time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Observe adapts the limiter to the throttling headers of resp.
+func (l *RateLimiter) Observe(resp *http.Response) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(now)
+
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil && float64(remaining) < l.tokens {
+		l.tokens = float64(remaining)
+	}
+
+	if wait, ok := RetryAfter(resp, now); ok && now.Add(wait).After(l.last) {
+		l.tokens = min(l.tokens, 0)
+		l.last = now.Add(wait)
+	}
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	if !now.After(l.last) {
+		return
+	}
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+}
+
+// RetryAfter parses the Retry-After header of resp, given in seconds or as
+// an HTTP date.
+func RetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
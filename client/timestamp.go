@@ -0,0 +1,66 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimestampLayout is the format Laravel uses to serialize dates.
+const TimestampLayout = "2006-01-02T15:04:05.000000Z"
+
+// Timestamp is a date returned by the Coolify API. It accepts the formats
+// listed in Unmarshal, and null or "" decode to the zero Timestamp, which
+// encodes back to null.
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp returns t as a Timestamp.
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+// ParseTimestamp parses a date in any of the formats Coolify uses. An empty
+// string yields the zero Timestamp.
+func ParseTimestamp(value string) (Timestamp, error) {
+	if strings.TrimSpace(value) == "" {
+		return Timestamp{}, nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return Timestamp{Time: parsed}, nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("invalid timestamp %q", value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("invalid timestamp %s", data)
+	}
+
+	parsed, err := ParseTimestamp(value)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler using TimestampLayout.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.UTC().Format(TimestampLayout))
+}
@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupportedByServer is returned when the Coolify instance is too old
+// for a feature.
+var ErrUnsupportedByServer = errors.New("not supported by this Coolify version")
+
+// Version is a parsed Coolify version such as 4.0.0-beta.360.
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease string
+}
+
+// ParseVersion parses a semantic version, with or without a leading "v".
+// Build metadata is ignored.
+func ParseVersion(value string) (Version, error) {
+	raw := strings.TrimPrefix(strings.Trim(strings.TrimSpace(value), `"`), "v")
+	raw, _, _ = strings.Cut(raw, "+")
+	core, prerelease, _ := strings.Cut(raw, "-")
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q", value)
+	}
+
+	numbers := [3]int{}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("invalid version %q", value)
+		}
+		numbers[i] = n
+	}
+
+	return Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2], Prerelease: prerelease}, nil
+}
+
+// String formats v as a semantic version.
+func (v Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// Compare returns -1, 0 or +1 depending on whether v precedes, equals or
+// follows other, following semantic versioning precedence.
+func (v Version) Compare(other Version) int {
+	for _, c := range [][2]int{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if c[0] != c[1] {
+			return compareInts(c[0], c[1])
+		}
+	}
+
+	switch {
+	case v.Prerelease == other.Prerelease:
+		return 0
+	case v.Prerelease == "":
+		return 1
+	case other.Prerelease == "":
+		return -1
+	}
+
+	a, b := strings.Split(v.Prerelease, "."), strings.Split(other.Prerelease, ".")
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := comparePrerelease(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInts(len(a), len(b))
+}
+
+// AtLeast reports whether v is min or newer.
+func (v Version) AtLeast(min Version) bool {
+	return v.Compare(min) >= 0
+}
+
+func comparePrerelease(a, b string) int {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return compareInts(x, y)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Capability names an API feature that only newer Coolify versions have.
+type Capability string
+
+const (
+	// CapabilityEnvironmentUUID is the environment_uuid field accepted when
+	// creating resources. Coolify's release notes do not say which beta added
+	// it, so it has no built-in minimum version and the SDK sends the field
+	// without checking; register one with RegisterCapability to check it
+	// with Supports.
+	CapabilityEnvironmentUUID Capability = "environment_uuid"
+)
+
+var (
+	capabilitiesMu sync.RWMutex
+	capabilities   = map[Capability]Version{}
+)
+
+// RegisterCapability records the first Coolify version supporting
+// capability, replacing any earlier registration.
+func RegisterCapability(capability Capability, minVersion string) error {
+	version, err := ParseVersion(minVersion)
+	if err != nil {
+		return err
+	}
+
+	capabilitiesMu.Lock()
+	defer capabilitiesMu.Unlock()
+	capabilities[capability] = version
+	return nil
+}
+
+// UnregisterCapability forgets the minimum version of capability, which is
+// then assumed to be supported.
+func UnregisterCapability(capability Capability) {
+	capabilitiesMu.Lock()
+	defer capabilitiesMu.Unlock()
+	delete(capabilities, capability)
+}
+
+// MinVersion returns the first Coolify version supporting capability.
+func MinVersion(capability Capability) (Version, bool) {
+	capabilitiesMu.RLock()
+	defer capabilitiesMu.RUnlock()
+	version, ok := capabilities[capability]
+	return version, ok
+}
+
+// versionCache holds the version of the instance a Client talks to.
+type versionCache struct {
+	mu      sync.Mutex
+	version *Version
+	call    *versionCall
+}
+
+// versionCall is a GET /version shared by concurrent callers.
+type versionCall struct {
+	done    chan struct{}
+	version Version
+	err     error
+}
+
+// ServerVersion returns the version reported by GET /version. It is fetched
+// once per Client; concurrent callers share the request, each returning as
+// soon as its own ctx is done.
+func (client *Client) ServerVersion(ctx context.Context) (Version, error) {
+	cache := client.version
+	if cache == nil {
+		cache = &versionCache{}
+	}
+
+	ctx = client.withOperation(ctx)
+
+	cache.mu.Lock()
+	if cache.version != nil {
+		version := *cache.version
+		cache.mu.Unlock()
+		return version, nil
+	}
+	call := cache.call
+	if call == nil {
+		call = &versionCall{done: make(chan struct{})}
+		cache.call = call
+		go client.loadVersion(ctx, cache, call)
+	}
+	cache.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.version, call.err
+	case <-ctx.Done():
+		return Version{}, ctx.Err()
+	}
+}
+
+// loadVersion runs call with a context detached from the caller that started
+// it and bounded by DefaultCacheLoadTimeout. A failed call is forgotten so
+// that the next caller retries.
+func (client *Client) loadVersion(ctx context.Context, cache *versionCache, call *versionCall) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), DefaultCacheLoadTimeout)
+	defer cancel()
+
+	call.version, call.err = client.fetchVersion(ctx)
+
+	cache.mu.Lock()
+	if call.err == nil {
+		cache.version = &call.version
+	}
+	cache.call = nil
+	cache.mu.Unlock()
+	close(call.done)
+}
+
+func (client *Client) fetchVersion(ctx context.Context) (Version, error) {
+	body, err := client.HttpRequestWithContext(ctx, "version", "GET")
+	if err != nil {
+		return Version{}, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return Version{}, err
+	}
+
+	return ParseVersion(string(data))
+}
+
+// RequireVersion returns an error wrapping ErrUnsupportedByServer when the
+// instance is older than min.
+func (client *Client) RequireVersion(ctx context.Context, min string) error {
+	minVersion, err := ParseVersion(min)
+	if err != nil {
+		return err
+	}
+
+	version, err := client.ServerVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Coolify version: %w", err)
+	}
+	if !version.AtLeast(minVersion) {
+		return fmt.Errorf("%w: Coolify %s is older than %s", ErrUnsupportedByServer, version, minVersion)
+	}
+	return nil
+}
+
+// Require returns an error wrapping ErrUnsupportedByServer when the instance
+// does not support capability. Unregistered capabilities are assumed to be
+// supported.
+func (client *Client) Require(ctx context.Context, capability Capability) error {
+	minVersion, ok := MinVersion(capability)
+	if !ok {
+		return nil
+	}
+
+	version, err := client.ServerVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Coolify version: %w", err)
+	}
+	if !version.AtLeast(minVersion) {
+		return fmt.Errorf("%w: %s requires Coolify %s, the instance runs %s", ErrUnsupportedByServer, capability, minVersion, version)
+	}
+	return nil
+}
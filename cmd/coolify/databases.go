@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/database"
+)
+
+var databaseColumns = []column[database.Database]{
+	{"UUID", func(d database.Database) string { return d.UUID }},
+	{"NAME", func(d database.Database) string { return d.Name }},
+	{"TYPE", func(d database.Database) string { return d.DatabaseType }},
+	{"IMAGE", func(d database.Database) string { return d.Image }},
+	{"STATUS", func(d database.Database) string { return d.Status }},
+	{"PUBLIC", func(d database.Database) string { return strconv.FormatBool(d.IsPublic) }},
+}
+
+// databaseAction builds a command calling a single-UUID database method.
+func databaseAction(name, done string, call func(a *app) func(context.Context, string) error) command {
+	return command{
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet(name, flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			if err := call(a)(ctx, rest[0]); err != nil {
+				return err
+			}
+			return message(a, "Database %s %s.", rest[0], done)
+		},
+	}
+}
+
+var databaseCommands = map[string]command{
+	"list": {
+		usage: "",
+		run: func(ctx context.Context, a *app, args []string) error {
+			if _, err := parseFlags(flag.NewFlagSet("list", flag.ContinueOnError), args, 0); err != nil {
+				return err
+			}
+
+			databases, err := a.sdk.Database.List(ctx)
+			if err != nil {
+				return err
+			}
+			return render(a, *databases, databaseColumns)
+		},
+	},
+	"get": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("get", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			db, err := a.sdk.Database.Get(ctx, rest[0])
+			if err != nil {
+				return err
+			}
+			return render(a, []database.Database{*db}, databaseColumns)
+		},
+	},
+	"start":   databaseAction("start", "starting", func(a *app) func(context.Context, string) error { return a.sdk.Database.Start }),
+	"stop":    databaseAction("stop", "stopping", func(a *app) func(context.Context, string) error { return a.sdk.Database.Stop }),
+	"restart": databaseAction("restart", "restarting", func(a *app) func(context.Context, string) error { return a.sdk.Database.Restart }),
+	"delete":  databaseAction("delete", "deleted", func(a *app) func(context.Context, string) error { return a.sdk.Database.Delete }),
+	"create-postgres": {
+		usage: "--server UUID --project UUID --environment NAME [--name NAME] [--image IMAGE] [--user USER] [--password PASSWORD] [--db NAME] [--public-port PORT] [--instant-deploy]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			dto := &database.CreateDatabasePostgresDTO{}
+			flags := flag.NewFlagSet("create-postgres", flag.ContinueOnError)
+			flags.StringVar(&dto.ServerUUID, "server", "", "server UUID")
+			flags.StringVar(&dto.ProjectUUID, "project", "", "project UUID")
+			flags.StringVar(&dto.Environment, "environment", "production", "environment name")
+			name := flags.String("name", "", "database name")
+			description := flags.String("description", "", "database description")
+			image := flags.String("image", "", "docker image")
+			user := flags.String("user", "", "postgres user")
+			password := flags.String("password", "", "postgres password")
+			db := flags.String("db", "", "postgres database")
+			publicPort := flags.Int("public-port", 0, "expose the database on this port")
+			instantDeploy := flags.Bool("instant-deploy", false, "start the database right away")
+			if _, err := parseFlags(flags, args, 0); err != nil {
+				return err
+			}
+
+			dto.Name = client.Optional(*name)
+			dto.Description = client.Optional(*description)
+			dto.Image = client.Optional(*image)
+			dto.PostgresUser = client.Optional(*user)
+			dto.PostgresPassword = client.Optional(*password)
+			dto.PostgresDB = client.Optional(*db)
+			if *publicPort != 0 {
+				dto.PublicPort = publicPort
+				dto.IsPublic = client.Optional(true)
+			}
+			dto.InstantDeploy = client.Optional(*instantDeploy)
+
+			uuid, err := a.sdk.Database.CreatePostgreSQL(ctx, dto)
+			if err != nil {
+				return err
+			}
+			return created(a, uuid)
+		},
+	},
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strconv"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+var keyColumns = []column[coolify_sdk.PrivateKey]{
+	{"UUID", func(k coolify_sdk.PrivateKey) string { return k.UUID }},
+	{"NAME", func(k coolify_sdk.PrivateKey) string { return k.Name }},
+	{"DESCRIPTION", func(k coolify_sdk.PrivateKey) string { return k.Description }},
+	{"GIT", func(k coolify_sdk.PrivateKey) string { return strconv.FormatBool(k.IsGitRelated) }},
+}
+
+var keyCommands = map[string]command{
+	"list": {
+		usage: "",
+		run: func(ctx context.Context, a *app, args []string) error {
+			if _, err := parseFlags(flag.NewFlagSet("list", flag.ContinueOnError), args, 0); err != nil {
+				return err
+			}
+
+			keys, err := a.sdk.PrivateKey.ListWithContext(ctx)
+			if err != nil {
+				return err
+			}
+			return render(a, *keys, keyColumns)
+		},
+	},
+	"get": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("get", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			key, err := a.sdk.PrivateKey.GetWithContext(ctx, rest[0])
+			if err != nil {
+				return err
+			}
+			return render(a, []coolify_sdk.PrivateKey{*key}, keyColumns)
+		},
+	},
+	"create": {
+		usage: "--name NAME --file PATH [--description TEXT]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			flags := flag.NewFlagSet("create", flag.ContinueOnError)
+			name := flags.String("name", "", "key name")
+			description := flags.String("description", "", "key description")
+			file := flags.String("file", "", "path to the PEM encoded private key")
+			if _, err := parseFlags(flags, args, 0); err != nil {
+				return err
+			}
+
+			pem, err := os.ReadFile(*file)
+			if err != nil {
+				return err
+			}
+
+			uuid, err := a.sdk.PrivateKey.CreateWithContext(ctx, &coolify_sdk.CreatePrivateKeyDTO{
+				Name:        *name,
+				Description: client.Optional(*description),
+				PrivateKey:  string(pem),
+			})
+			if err != nil {
+				return err
+			}
+			return created(a, uuid)
+		},
+	},
+	"delete": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("delete", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			if err := a.sdk.PrivateKey.DeleteWithContext(ctx, rest[0]); err != nil {
+				return err
+			}
+			return message(a, "Private key %s deleted.", rest[0])
+		},
+	},
+}
@@ -0,0 +1,176 @@
+// Command coolify manages a Coolify instance from the shell.
+//
+//	coolify [--profile NAME] [--host URL] [--token TOKEN] [--output table|json|yaml] [--show-secrets] <resource> <action> [flags] [args]
+//
+// The host and token come from the selected profile of
+// ~/.config/coolify/config.yaml, overridden by the COOLIFY_HOST and
+// COOLIFY_TOKEN environment variables and then by the flags.
+//
+// JSON and YAML output redact passwords, private keys, tokens and the
+// credentials of connection URLs unless --show-secrets is given.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/config"
+)
+
+// command is a single resource action, e.g. "servers list".
+type command struct {
+	usage string
+	run   func(ctx context.Context, app *app, args []string) error
+}
+
+var resources = map[string]map[string]command{
+	"servers":   serverCommands,
+	"keys":      keyCommands,
+	"projects":  projectCommands,
+	"teams":     teamCommands,
+	"databases": databaseCommands,
+}
+
+// app carries the state shared by every command.
+type app struct {
+	sdk         *coolify_sdk.Sdk
+	profile     *config.Profile
+	out         io.Writer
+	format      string
+	showSecrets bool
+}
+
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr, os.Getenv); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "error:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer, getenv func(string) string) error {
+	flags := flag.NewFlagSet("coolify", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() { printUsage(stderr, flags) }
+
+	configPath := flags.String("config", "", "config file (default ~/.config/coolify/config.yaml)")
+	profileName := flags.String("profile", "", "config profile (env COOLIFY_PROFILE)")
+	host := flags.String("host", "", "Coolify URL (env COOLIFY_HOST)")
+	token := flags.String("token", "", "API token (env COOLIFY_TOKEN)")
+	format := flags.String("output", "table", "output format: table, json or yaml")
+	showSecrets := flags.Bool("show-secrets", false, "show passwords, private keys and tokens in JSON and YAML output")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+
+	if flags.NArg() < 2 {
+		flags.Usage()
+		return errUsage
+	}
+
+	actions, ok := resources[flags.Arg(0)]
+	if !ok {
+		flags.Usage()
+		return errUsage
+	}
+
+	cmd, ok := actions[flags.Arg(1)]
+	if !ok {
+		flags.Usage()
+		return errUsage
+	}
+
+	switch *format {
+	case "table", "json", "yaml":
+	default:
+		return fmt.Errorf("unknown output format %q", *format)
+	}
+
+	profile, err := config.Resolve(config.Options{
+		Path:    *configPath,
+		Profile: *profileName,
+		Getenv: func(key string) string {
+			if key == config.EnvHost && *host != "" {
+				return *host
+			}
+			if key == config.EnvToken && *token != "" {
+				return *token
+			}
+			return getenv(key)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	sdk, err := profile.SDK()
+	if err != nil {
+		return err
+	}
+
+	a := &app{
+		sdk:         sdk,
+		profile:     profile,
+		out:         stdout,
+		format:      *format,
+		showSecrets: *showSecrets,
+	}
+
+	return cmd.run(ctx, a, flags.Args()[2:])
+}
+
+func printUsage(w io.Writer, flags *flag.FlagSet) {
+	fmt.Fprintln(w, "Usage: coolify [flags] <resource> <action> [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Flags:")
+	flags.PrintDefaults()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		actions := make([]string, 0, len(resources[name]))
+		for action := range resources[name] {
+			actions = append(actions, action)
+		}
+		sort.Strings(actions)
+
+		for _, action := range actions {
+			fmt.Fprintf(w, "  %s %s %s\n", name, action, resources[name][action].usage)
+		}
+	}
+}
+
+// parseFlags parses action flags, requiring exactly want positional args.
+func parseFlags(flags *flag.FlagSet, args []string, want int) ([]string, error) {
+	flags.SetOutput(io.Discard)
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() != want {
+		return nil, fmt.Errorf("expected %d argument(s), got %d", want, flags.NArg())
+	}
+	return flags.Args(), nil
+}
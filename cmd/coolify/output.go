@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/marconneves/coolify-sdk-go/recorder"
+)
+
+// column renders one field of T in table output.
+type column[T any] struct {
+	header string
+	value  func(T) string
+}
+
+// render writes items in the app's output format. Tables only show the
+// given columns, JSON and YAML show every field, with secrets redacted
+// unless --show-secrets is set.
+func render[T any](a *app, items []T, columns []column[T]) error {
+	if a.format != "table" {
+		return encode(a, items)
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = c.header
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, item := range items {
+		values := make([]string, len(columns))
+		for i, c := range columns {
+			values[i] = c.value(item)
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+
+	return w.Flush()
+}
+
+// encode writes v as JSON or YAML. YAML goes through JSON so that its keys
+// match the API field names. Passwords, keys, tokens and the credentials of
+// connection URLs are redacted unless the app shows secrets.
+func encode(a *app, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if !a.showSecrets {
+		data = recorder.ScrubJSON(data)
+	}
+
+	if a.format == "json" {
+		var out bytes.Buffer
+		if err := json.Indent(&out, data, "", "  "); err != nil {
+			return err
+		}
+		out.WriteByte('\n')
+		_, err := out.WriteTo(a.out)
+		return err
+	}
+
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+
+	encoder := yaml.NewEncoder(a.out)
+	defer encoder.Close()
+	encoder.SetIndent(2)
+	return encoder.Encode(generic)
+}
+
+// message prints a confirmation in table mode, or a {"message": ...}
+// document otherwise.
+func message(a *app, format string, args ...any) error {
+	text := fmt.Sprintf(format, args...)
+	if a.format != "table" {
+		return encode(a, map[string]string{"message": text})
+	}
+
+	_, err := fmt.Fprintln(a.out, text)
+	return err
+}
+
+// created prints the UUID of a newly created resource.
+func created(a *app, uuid *string) error {
+	if a.format != "table" {
+		return encode(a, map[string]string{"uuid": *uuid})
+	}
+
+	_, err := fmt.Fprintln(a.out, *uuid)
+	return err
+}
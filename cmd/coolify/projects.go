@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+var projectColumns = []column[coolify_sdk.Project]{
+	{"UUID", func(p coolify_sdk.Project) string { return p.UUID }},
+	{"NAME", func(p coolify_sdk.Project) string { return p.Name }},
+	{"DESCRIPTION", func(p coolify_sdk.Project) string { return client.Deref(p.Description) }},
+	{"ENVIRONMENTS", func(p coolify_sdk.Project) string {
+		names := make([]string, len(p.Environments))
+		for i, environment := range p.Environments {
+			names[i] = environment.Name
+		}
+		return strings.Join(names, ",")
+	}},
+}
+
+var projectCommands = map[string]command{
+	"list": {
+		usage: "",
+		run: func(ctx context.Context, a *app, args []string) error {
+			if _, err := parseFlags(flag.NewFlagSet("list", flag.ContinueOnError), args, 0); err != nil {
+				return err
+			}
+
+			projects, err := a.sdk.Project.ListWithContext(ctx)
+			if err != nil {
+				return err
+			}
+			return render(a, *projects, projectColumns)
+		},
+	},
+	"get": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("get", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			project, err := a.sdk.Project.GetWithContext(ctx, rest[0])
+			if err != nil {
+				return err
+			}
+			return render(a, []coolify_sdk.Project{*project}, projectColumns)
+		},
+	},
+	"create": {
+		usage: "--name NAME [--description TEXT]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			flags := flag.NewFlagSet("create", flag.ContinueOnError)
+			name := flags.String("name", "", "project name")
+			description := flags.String("description", "", "project description")
+			if _, err := parseFlags(flags, args, 0); err != nil {
+				return err
+			}
+
+			uuid, err := a.sdk.Project.CreateWithContext(ctx, &coolify_sdk.CreateProjectDTO{
+				Name:        client.Optional(*name),
+				Description: client.Optional(*description),
+			})
+			if err != nil {
+				return err
+			}
+			return created(a, uuid)
+		},
+	},
+	"delete": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("delete", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			if err := a.sdk.Project.DeleteWithContext(ctx, rest[0]); err != nil {
+				return err
+			}
+			return message(a, "Project %s deleted.", rest[0])
+		},
+	},
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+var serverColumns = []column[server.Server]{
+	{"UUID", func(s server.Server) string { return s.UUID }},
+	{"NAME", func(s server.Server) string { return s.Name }},
+	{"IP", func(s server.Server) string { return s.IP }},
+	{"PORT", func(s server.Server) string { return strconv.Itoa(s.Port) }},
+	{"USER", func(s server.Server) string { return s.User }},
+	{"REACHABLE", func(s server.Server) string {
+		if s.Settings == nil {
+			return ""
+		}
+		return strconv.FormatBool(s.Settings.IsReachable)
+	}},
+}
+
+var serverCommands = map[string]command{
+	"list": {
+		usage: "",
+		run: func(ctx context.Context, a *app, args []string) error {
+			if _, err := parseFlags(flag.NewFlagSet("list", flag.ContinueOnError), args, 0); err != nil {
+				return err
+			}
+
+			servers, err := a.sdk.Server.ListWithContext(ctx)
+			if err != nil {
+				return err
+			}
+			return render(a, *servers, serverColumns)
+		},
+	},
+	"get": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("get", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			srv, err := a.sdk.Server.GetWithContext(ctx, rest[0])
+			if err != nil {
+				return err
+			}
+			return render(a, []server.Server{*srv}, serverColumns)
+		},
+	},
+	"create": {
+		usage: "--name NAME --ip IP --private-key UUID [--port 22] [--user root] [--description TEXT] [--build-server] [--validate]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			dto := &server.CreateServerDTO{}
+			flags := flag.NewFlagSet("create", flag.ContinueOnError)
+			flags.StringVar(&dto.Name, "name", "", "server name")
+			flags.StringVar(&dto.Description, "description", "", "server description")
+			flags.StringVar(&dto.IP, "ip", "", "IP address or hostname")
+			flags.IntVar(&dto.Port, "port", 22, "SSH port")
+			flags.StringVar(&dto.User, "user", "root", "SSH user")
+			flags.StringVar(&dto.PrivateKeyUUID, "private-key", "", "private key UUID")
+			flags.BoolVar(&dto.IsBuildServer, "build-server", false, "use as a build server")
+			flags.BoolVar(&dto.InstantValidate, "validate", false, "validate the server right away")
+			if _, err := parseFlags(flags, args, 0); err != nil {
+				return err
+			}
+
+			uuid, err := a.sdk.Server.CreateWithContext(ctx, dto)
+			if err != nil {
+				return err
+			}
+			return created(a, uuid)
+		},
+	},
+	"delete": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("delete", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			if err := a.sdk.Server.DeleteWithContext(ctx, rest[0]); err != nil {
+				return err
+			}
+			return message(a, "Server %s deleted.", rest[0])
+		},
+	},
+	"validate": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("validate", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			if err := a.sdk.Server.ValidateWithContext(ctx, rest[0]); err != nil {
+				return err
+			}
+			return message(a, "Validation of server %s started.", rest[0])
+		},
+	},
+	"resources": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("resources", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			resources, err := a.sdk.Server.ResourcesWithContext(ctx, rest[0])
+			if err != nil {
+				return err
+			}
+			return render(a, *resources, []column[server.Resource]{
+				{"UUID", func(r server.Resource) string { return r.UUID }},
+				{"NAME", func(r server.Resource) string { return r.Name }},
+				{"TYPE", func(r server.Resource) string { return r.Type }},
+				{"STATUS", func(r server.Resource) string { return r.Status }},
+			})
+		},
+	},
+	"domains": {
+		usage: "<uuid>",
+		run: func(ctx context.Context, a *app, args []string) error {
+			rest, err := parseFlags(flag.NewFlagSet("domains", flag.ContinueOnError), args, 1)
+			if err != nil {
+				return err
+			}
+
+			domains, err := a.sdk.Server.DomainsWithContext(ctx, rest[0])
+			if err != nil {
+				return err
+			}
+			return render(a, *domains, []column[server.Domain]{
+				{"ID", func(d server.Domain) string { return strconv.Itoa(d.Id) }},
+				{"DOMAINS", func(d server.Domain) string { return strings.Join(d.Domains, ",") }},
+			})
+		},
+	},
+}
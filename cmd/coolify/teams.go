@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+var teamColumns = []column[coolify_sdk.Team]{
+	{"ID", func(t coolify_sdk.Team) string { return strconv.Itoa(t.Id) }},
+	{"NAME", func(t coolify_sdk.Team) string { return t.Name }},
+	{"DESCRIPTION", func(t coolify_sdk.Team) string { return client.Deref(t.Description) }},
+}
+
+// teamID parses the team ID positional argument, falling back to the
+// profile's default team.
+func teamID(a *app, flags *flag.FlagSet, args []string) (int, error) {
+	if len(args) == 0 && a.profile.DefaultTeam != nil {
+		return *a.profile.DefaultTeam, nil
+	}
+
+	rest, err := parseFlags(flags, args, 1)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(rest[0])
+}
+
+var teamCommands = map[string]command{
+	"list": {
+		usage: "",
+		run: func(ctx context.Context, a *app, args []string) error {
+			if _, err := parseFlags(flag.NewFlagSet("list", flag.ContinueOnError), args, 0); err != nil {
+				return err
+			}
+
+			teams, err := a.sdk.Team.ListWithContext(ctx)
+			if err != nil {
+				return err
+			}
+			return render(a, *teams, teamColumns)
+		},
+	},
+	"get": {
+		usage: "[id]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			id, err := teamID(a, flag.NewFlagSet("get", flag.ContinueOnError), args)
+			if err != nil {
+				return err
+			}
+
+			team, err := a.sdk.Team.GetWithContext(ctx, id)
+			if err != nil {
+				return err
+			}
+			return render(a, []coolify_sdk.Team{*team}, teamColumns)
+		},
+	},
+	"members": {
+		usage: "[id]",
+		run: func(ctx context.Context, a *app, args []string) error {
+			id, err := teamID(a, flag.NewFlagSet("members", flag.ContinueOnError), args)
+			if err != nil {
+				return err
+			}
+
+			members, err := a.sdk.Team.MembersWithContext(ctx, id)
+			if err != nil {
+				return err
+			}
+			return render(a, *members, []column[coolify_sdk.Member]{
+				{"ID", func(m coolify_sdk.Member) string { return strconv.Itoa(m.Id) }},
+				{"NAME", func(m coolify_sdk.Member) string { return m.Name }},
+				{"EMAIL", func(m coolify_sdk.Member) string { return m.Email }},
+			})
+		},
+	},
+}
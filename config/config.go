@@ -0,0 +1,301 @@
+// Package config loads named Coolify profiles from
+// ~/.config/coolify/config.yaml and builds an SDK from them.
+//
+//	current_profile: staging
+//	profiles:
+//	  staging:
+//	    host: https://coolify.staging.example.com
+//	    token_command: pass show coolify/staging
+//	    default_team: 1
+//	  lab:
+//	    host: https://coolify.lab.internal
+//	    token: 3|abcdef
+//	    tls:
+//	      ca_file: /etc/ssl/lab-ca.pem
+//
+// The COOLIFY_PROFILE, COOLIFY_HOST and COOLIFY_TOKEN environment variables
+// select a profile and override its host and token.
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// DefaultProfile is used when no profile is selected.
+const DefaultProfile = "default"
+
+// Environment variables read by Resolve.
+const (
+	EnvProfile = "COOLIFY_PROFILE"
+	EnvHost    = "COOLIFY_HOST"
+	EnvToken   = "COOLIFY_TOKEN"
+)
+
+// File is the content of a config file.
+type File struct {
+	CurrentProfile string              `yaml:"current_profile,omitempty"`
+	Profiles       map[string]*Profile `yaml:"profiles"`
+}
+
+// Profile describes how to reach one Coolify instance.
+type Profile struct {
+	// Name is the profile key in the config file.
+	Name string `yaml:"-"`
+
+	Host string `yaml:"host"`
+	// Token is the API token. TokenCommand, when set, is run through the
+	// shell and its trimmed output is used instead.
+	Token        string    `yaml:"token,omitempty"`
+	TokenCommand string    `yaml:"token_command,omitempty"`
+	DefaultTeam  *int      `yaml:"default_team,omitempty"`
+	TLS          TLSConfig `yaml:"tls,omitempty"`
+}
+
+// TLSConfig customises the TLS settings used to reach an instance.
+type TLSConfig struct {
+	CAFile             string `yaml:"ca_file,omitempty"`
+	CertFile           string `yaml:"cert_file,omitempty"`
+	KeyFile            string `yaml:"key_file,omitempty"`
+	ServerName         string `yaml:"server_name,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
+}
+
+// Options controls how a profile is resolved.
+type Options struct {
+	// Path of the config file. Defaults to DefaultPath.
+	Path string
+	// Profile overrides COOLIFY_PROFILE and current_profile.
+	Profile string
+	// Getenv defaults to os.Getenv.
+	Getenv func(string) string
+	// TokenCommandTimeout bounds a profile's token_command. Defaults to
+	// DefaultTokenCommandTimeout.
+	TokenCommandTimeout time.Duration
+	// ClientOptions are passed to the SDK client built by Open.
+	ClientOptions []client.Option
+}
+
+// DefaultTokenCommandTimeout is the TokenCommandTimeout used when it is
+// zero.
+const DefaultTokenCommandTimeout = 30 * time.Second
+
+// DefaultPath returns $XDG_CONFIG_HOME/coolify/config.yaml, falling back to
+// ~/.config/coolify/config.yaml.
+func DefaultPath() (string, error) {
+	return defaultPath(os.Getenv)
+}
+
+func defaultPath(getenv func(string) string) (string, error) {
+	if dir := getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "coolify", "config.yaml"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "coolify", "config.yaml"), nil
+}
+
+// Load reads a config file. A missing file yields an empty File.
+func Load(path string) (*File, error) {
+	file := &File{Profiles: map[string]*Profile{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	if file.Profiles == nil {
+		file.Profiles = map[string]*Profile{}
+	}
+	for name, profile := range file.Profiles {
+		if profile == nil {
+			profile = &Profile{}
+			file.Profiles[name] = profile
+		}
+		profile.Name = name
+	}
+
+	return file, nil
+}
+
+// Resolve selects a profile and applies environment overrides. It fails when
+// no host or token can be determined.
+func Resolve(opts Options) (*Profile, error) {
+	getenv := opts.Getenv
+	if getenv == nil {
+		getenv = os.Getenv
+	}
+
+	path := opts.Path
+	if path == "" {
+		var err error
+		path, err = defaultPath(getenv)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	name := firstNonEmpty(opts.Profile, getenv(EnvProfile), file.CurrentProfile)
+	explicit := name != ""
+	if name == "" {
+		name = DefaultProfile
+	}
+
+	profile := &Profile{Name: name}
+	if found, ok := file.Profiles[name]; ok {
+		copied := *found
+		profile = &copied
+	} else if explicit {
+		return nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+
+	if host := getenv(EnvHost); host != "" {
+		profile.Host = host
+	}
+	if token := getenv(EnvToken); token != "" {
+		profile.Token = token
+		profile.TokenCommand = ""
+	}
+
+	if profile.TokenCommand != "" {
+		timeout := opts.TokenCommandTimeout
+		if timeout <= 0 {
+			timeout = DefaultTokenCommandTimeout
+		}
+		token, err := runTokenCommand(profile.TokenCommand, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("profile %q: %w", name, err)
+		}
+		profile.Token = token
+	}
+
+	profile.Host = strings.TrimSuffix(profile.Host, "/")
+	if profile.Host == "" {
+		return nil, fmt.Errorf("profile %q has no host, set it in %s or %s", name, path, EnvHost)
+	}
+	if profile.Token == "" {
+		return nil, fmt.Errorf("profile %q has no token, set it in %s or %s", name, path, EnvToken)
+	}
+
+	return profile, nil
+}
+
+// Open resolves a profile and returns an SDK configured for it.
+func Open(opts Options) (*coolify_sdk.Sdk, error) {
+	profile, err := Resolve(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.SDK(opts.ClientOptions...)
+}
+
+// SDK returns an SDK configured for the profile.
+func (p *Profile) SDK(opts ...client.Option) (*coolify_sdk.Sdk, error) {
+	httpClient, err := p.TLS.httpClient()
+	if err != nil {
+		return nil, fmt.Errorf("profile %q: %w", p.Name, err)
+	}
+	if httpClient != nil {
+		opts = append([]client.Option{client.WithHTTPClient(httpClient)}, opts...)
+	}
+
+	return coolify_sdk.Init(p.Host, p.Token, opts...), nil
+}
+
+// httpClient returns nil when the default TLS settings apply.
+func (t TLSConfig) httpClient() (*http.Client, error) {
+	if t == (TLSConfig{}) {
+		return nil, nil
+	}
+
+	config := &tls.Config{
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+
+	if t.CAFile != "" {
+		pem, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", t.CAFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if t.CertFile != "" || t.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = config
+	return &http.Client{Transport: transport}, nil
+}
+
+func runTokenCommand(command string, timeout time.Duration) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Stderr = os.Stderr
+	// Do not wait for children of the shell that keep its output open.
+	cmd.WaitDelay = time.Second
+
+	out, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("token command timed out after %v", timeout)
+		}
+		return "", fmt.Errorf("token command failed: %w", err)
+	}
+
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		return "", errors.New("token command printed nothing")
+	}
+	return token, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
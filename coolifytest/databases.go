@@ -0,0 +1,321 @@
+package coolifytest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/database"
+	"github.com/marconneves/coolify-sdk-go/destination"
+)
+
+// databaseKinds describes the database types the fake can create, keyed by
+// the path segment of their create endpoint.
+var databaseKinds = map[string]struct {
+	kind     string
+	image    string
+	port     int
+	defaults map[string]any
+}{
+	"postgresql": {
+		kind:  "standalone-postgresql",
+		image: "postgres:16-alpine",
+		port:  5432,
+		defaults: map[string]any{
+			"postgres_user": "postgres",
+			"postgres_db":   "postgres",
+		},
+	},
+	"mysql": {
+		kind:  "standalone-mysql",
+		image: "mysql:8",
+		port:  3306,
+		defaults: map[string]any{
+			"mysql_user":     "mysql",
+			"mysql_database": "default",
+		},
+	},
+	"mariadb": {
+		kind:  "standalone-mariadb",
+		image: "mariadb:11",
+		port:  3306,
+		defaults: map[string]any{
+			"mariadb_user":     "mariadb",
+			"mariadb_database": "default",
+		},
+	},
+	"redis": {
+		kind:     "standalone-redis",
+		image:    "redis:7.2",
+		port:     6379,
+		defaults: map[string]any{},
+	},
+}
+
+// AddDatabase seeds a database on the default destination of serverUUID and
+// returns its UUID. The server must have been added first.
+func (s *Server) AddDatabase(serverUUID string, db database.Database) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	srv, ok := s.servers[serverUUID]
+	if !ok {
+		panic("coolifytest: unknown server " + serverUUID)
+	}
+
+	if db.Status == "" {
+		db.Status = "running:healthy"
+	}
+	destination := s.defaultDestination(srv.Settings.ServerId)
+	if destination == nil {
+		panic("coolifytest: server " + serverUUID + " has no destination")
+	}
+	db.Destination = *destination
+	db.Destination.Server = *srv
+	db.DestinationId = db.Destination.ID
+
+	return s.storeDatabase(db)
+}
+
+func (s *Server) storeDatabase(db database.Database) string {
+	if db.UUID == "" {
+		db.UUID = newUUID()
+	}
+	if db.CreatedAt.IsZero() {
+		db.CreatedAt = client.NewTimestamp(now())
+		db.UpdatedAt = db.CreatedAt
+	}
+	db.DestinationType = "App\\Models\\StandaloneDocker"
+	if db.Destination.Type == destination.Swarm {
+		db.DestinationType = "App\\Models\\SwarmDocker"
+	}
+
+	s.databases[db.UUID] = &db
+	return db.UUID
+}
+
+func (s *Server) defaultDestination(serverID int) *database.Destination {
+	if destinations := s.serverDestinations(serverID); len(destinations) > 0 {
+		return destinations[0]
+	}
+	return nil
+}
+
+func (s *Server) serverDatabases(serverUUID string) []*database.Database {
+	var databases []*database.Database
+	for _, db := range s.databases {
+		if db.Destination.Server.UUID == serverUUID {
+			databases = append(databases, db)
+		}
+	}
+	return databases
+}
+
+func (s *Server) databaseRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/databases", s.authorized(s.listDatabases, false))
+	mux.HandleFunc("POST /api/v1/databases/{kind}", s.authorized(s.createDatabase, false))
+	mux.HandleFunc("GET /api/v1/databases/{uuid}", s.authorized(s.getDatabase, false))
+	mux.HandleFunc("PATCH /api/v1/databases/{uuid}", s.authorized(s.updateDatabase, false))
+	mux.HandleFunc("DELETE /api/v1/databases/{uuid}", s.authorized(s.deleteDatabase, false))
+	mux.HandleFunc("GET /api/v1/databases/{uuid}/start", s.authorized(s.databaseAction("running:healthy", "Database starting request queued."), false))
+	mux.HandleFunc("GET /api/v1/databases/{uuid}/stop", s.authorized(s.databaseAction("exited", "Database stopping request queued."), false))
+	mux.HandleFunc("GET /api/v1/databases/{uuid}/restart", s.authorized(s.databaseAction("running:healthy", "Database restarting request queued."), false))
+}
+
+func (s *Server) findDatabase(w http.ResponseWriter, r *http.Request) (*database.Database, bool) {
+	db, ok := s.databases[r.PathValue("uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Database not found.")
+	}
+	return db, ok
+}
+
+func (s *Server) listDatabases(w http.ResponseWriter, r *http.Request) {
+	databases := make([]database.Database, 0, len(s.databases))
+	for _, db := range s.databases {
+		databases = append(databases, *db)
+	}
+	slices.SortFunc(databases, func(a, b database.Database) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.UUID, b.UUID)
+	})
+
+	writeJSON(w, http.StatusOK, databases)
+}
+
+func (s *Server) getDatabase(w http.ResponseWriter, r *http.Request) {
+	if db, ok := s.findDatabase(w, r); ok {
+		writeJSON(w, http.StatusOK, db)
+	}
+}
+
+func (s *Server) createDatabase(w http.ResponseWriter, r *http.Request) {
+	kind, ok := databaseKinds[r.PathValue("kind")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Not found.")
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.require(body, "server_uuid", "project_uuid")
+	if stringField(body, "environment_name") == "" && stringField(body, "environment_uuid") == "" {
+		errs.add("environment_name", "The environment name field is required when environment uuid is not present.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	srv, ok := s.servers[stringField(body, "server_uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Server not found.")
+		return
+	}
+
+	project, ok := s.projects[stringField(body, "project_uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Project not found.")
+		return
+	}
+
+	environmentID := 0
+	for _, environment := range project.Environments {
+		if environment.Name == stringField(body, "environment_name") || fmt.Sprint(environment.ID) == stringField(body, "environment_uuid") {
+			environmentID = int(environment.ID)
+		}
+	}
+	if environmentID == 0 {
+		writeMessage(w, http.StatusNotFound, "Environment not found.")
+		return
+	}
+
+	destination := s.defaultDestination(srv.Settings.ServerId)
+	if len(s.serverDestinations(srv.Settings.ServerId)) > 1 && stringField(body, "destination_uuid") == "" {
+		writeMessage(w, http.StatusUnprocessableEntity, "Server has multiple destinations and you do not set destination_uuid.")
+		return
+	}
+	if uuid := stringField(body, "destination_uuid"); uuid != "" {
+		destination = s.destinations[uuid]
+		if destination == nil || destination.ServerID != srv.Settings.ServerId {
+			writeMessage(w, http.StatusNotFound, "Destination not found.")
+			return
+		}
+	}
+	if destination == nil {
+		writeMessage(w, http.StatusUnprocessableEntity, "Server has no destinations.")
+		return
+	}
+
+	uuid := newUUID()
+	fields := map[string]any{
+		"name":  fmt.Sprintf("%s-database-%s", r.PathValue("kind"), uuid),
+		"image": kind.image,
+	}
+	for key, value := range kind.defaults {
+		fields[key] = value
+	}
+	for key, value := range body {
+		fields[key] = value
+	}
+
+	db := database.Database{
+		UUID:         uuid,
+		DatabaseType: kind.kind,
+		Status:       "exited",
+	}
+	if err := merge(&db, fields); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+
+	db.EnvironmentID = environmentID
+	db.Destination = *destination
+	db.Destination.Server = *srv
+	db.DestinationId = destination.ID
+	user, password := databaseCredentials(&db)
+	db.InternalDbURL = fmt.Sprintf("%s://%s@%s:%d", r.PathValue("kind"), url.UserPassword(user, password), uuid, kind.port)
+	if boolField(body, "instant_deploy") {
+		db.Status = "running:healthy"
+	}
+
+	s.storeDatabase(db)
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"uuid":            db.UUID,
+		"internal_db_url": db.InternalDbURL,
+	})
+}
+
+func (s *Server) updateDatabase(w http.ResponseWriter, r *http.Request) {
+	db, ok := s.findDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, jsonFields(database.UpdateDatabaseDTO{}))
+	if errs.write(w) {
+		return
+	}
+
+	if err := merge(db, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+	db.UpdatedAt = client.NewTimestamp(now())
+
+	writeMessage(w, http.StatusOK, "Database updated.")
+}
+
+func (s *Server) deleteDatabase(w http.ResponseWriter, r *http.Request) {
+	db, ok := s.findDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.databases, db.UUID)
+	delete(s.storages, db.UUID)
+	writeMessage(w, http.StatusOK, "Database deletion request queued.")
+}
+
+func (s *Server) databaseAction(status, message string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		db, ok := s.findDatabase(w, r)
+		if !ok {
+			return
+		}
+
+		db.Status = status
+		if strings.HasPrefix(status, "running") {
+			db.StartedAt = client.NewTimestamp(now())
+		}
+		writeMessage(w, http.StatusOK, message)
+	}
+}
+
+// databaseCredentials returns the user and password Coolify puts in the
+// connection URLs of db.
+func databaseCredentials(db *database.Database) (string, string) {
+	switch db.DatabaseType {
+	case "standalone-postgresql":
+		return db.PostgresUser, db.PostgresPassword
+	case "standalone-mysql":
+		return client.Deref(db.MysqlUser), client.Deref(db.MysqlPassword)
+	case "standalone-mariadb":
+		return client.Deref(db.MariadbUser), client.Deref(db.MariadbPassword)
+	}
+	return "default", client.Deref(db.RedisPassword)
+}
@@ -0,0 +1,129 @@
+package coolifytest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/destination"
+)
+
+func (s *Server) destinationRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/destinations", s.authorized(s.listDestinations, false))
+	mux.HandleFunc("POST /api/v1/destinations", s.authorized(s.createDestination, false))
+	mux.HandleFunc("GET /api/v1/destinations/{uuid}", s.authorized(s.getDestination, false))
+	mux.HandleFunc("DELETE /api/v1/destinations/{uuid}", s.authorized(s.deleteDestination, false))
+}
+
+func (s *Server) findDestination(w http.ResponseWriter, r *http.Request) (*destination.Destination, bool) {
+	dest, ok := s.destinations[r.PathValue("uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Destination not found.")
+	}
+	return dest, ok
+}
+
+// serverDestinations returns the destinations of a server ordered by ID.
+func (s *Server) serverDestinations(serverID int) []*destination.Destination {
+	var destinations []*destination.Destination
+	for _, dest := range s.destinations {
+		if dest.ServerID == serverID {
+			destinations = append(destinations, dest)
+		}
+	}
+	slices.SortFunc(destinations, func(a, b *destination.Destination) int { return a.ID - b.ID })
+	return destinations
+}
+
+func (s *Server) listDestinations(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.servers[r.URL.Query().Get("server_uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Server not found.")
+		return
+	}
+
+	destinations := []destination.Destination{}
+	for _, dest := range s.serverDestinations(srv.Settings.ServerId) {
+		destinations = append(destinations, *dest)
+	}
+
+	writeJSON(w, http.StatusOK, destinations)
+}
+
+func (s *Server) getDestination(w http.ResponseWriter, r *http.Request) {
+	if dest, ok := s.findDestination(w, r); ok {
+		writeJSON(w, http.StatusOK, dest)
+	}
+}
+
+func (s *Server) createDestination(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, jsonFields(destination.CreateDestinationDTO{}))
+	errs.require(body, "server_uuid", "network")
+	kind := destination.Type(stringField(body, "type"))
+	if kind == "" {
+		kind = destination.Standalone
+	}
+	if kind != destination.Standalone && kind != destination.Swarm {
+		errs.add("type", "The selected type is invalid.")
+	}
+	if errs.write(w) {
+		return
+	}
+
+	srv, ok := s.servers[stringField(body, "server_uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Server not found.")
+		return
+	}
+	if kind == destination.Swarm && !srv.Settings.IsSwarmManager {
+		writeMessage(w, http.StatusUnprocessableEntity, "Server is not a Swarm manager.")
+		return
+	}
+	for _, dest := range s.serverDestinations(srv.Settings.ServerId) {
+		if dest.Network == stringField(body, "network") {
+			writeMessage(w, http.StatusConflict, "Network already added to this server.")
+			return
+		}
+	}
+
+	name := stringField(body, "name")
+	if name == "" {
+		name = stringField(body, "network")
+	}
+	dest := &destination.Destination{
+		ID:        s.newID(),
+		UUID:      newUUID(),
+		Name:      name,
+		Network:   stringField(body, "network"),
+		Type:      kind,
+		ServerID:  srv.Settings.ServerId,
+		CreatedAt: client.NewTimestamp(now()),
+	}
+	dest.UpdatedAt = dest.CreatedAt
+	s.destinations[dest.UUID] = dest
+
+	writeJSON(w, http.StatusCreated, map[string]string{"uuid": dest.UUID})
+}
+
+func (s *Server) deleteDestination(w http.ResponseWriter, r *http.Request) {
+	dest, ok := s.findDestination(w, r)
+	if !ok {
+		return
+	}
+
+	for _, db := range s.databases {
+		if db.DestinationId == dest.ID {
+			writeMessage(w, http.StatusUnprocessableEntity, "Destination has resources, so you need to delete them before.")
+			return
+		}
+	}
+
+	delete(s.destinations, dest.UUID)
+	writeMessage(w, http.StatusOK, "Destination deleted.")
+}
@@ -0,0 +1,99 @@
+package coolifytest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fault describes an error or delay injected into matching requests.
+type Fault struct {
+	// Method restricts the fault to an HTTP method. Empty matches any method.
+	Method string
+	// Path restricts the fault to API paths starting with this prefix,
+	// relative to /api/v1/ (e.g. "servers"). Empty matches any path.
+	Path string
+	// Latency delays the response.
+	Latency time.Duration
+	// Status, when non-zero, replaces the response with this status code.
+	Status int
+	// RetryAfter sets the Retry-After header on injected responses.
+	RetryAfter time.Duration
+	// Times limits how many requests the fault applies to. Zero means
+	// every matching request.
+	Times int
+
+	hits int
+}
+
+// Inject registers a fault. Faults are evaluated in registration order and
+// the first match applies.
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+func (s *Server) takeFault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1/")
+	for _, fault := range s.faults {
+		if fault.Method != "" && fault.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(path, fault.Path) {
+			continue
+		}
+		if fault.Times > 0 && fault.hits >= fault.Times {
+			continue
+		}
+		fault.hits++
+		return fault
+	}
+	return nil
+}
+
+func (s *Server) withFaults(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fault := s.takeFault(r)
+		if fault == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if fault.Status == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(fault.RetryAfter.Seconds())))
+		}
+
+		message := http.StatusText(fault.Status)
+		if fault.Status == http.StatusTooManyRequests {
+			message = "Too Many Attempts."
+		}
+		writeMessage(w, fault.Status, message)
+	})
+}
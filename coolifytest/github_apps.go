@@ -0,0 +1,209 @@
+package coolifytest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// AddGitHubApp seeds a GitHub App source and returns its ID.
+func (s *Server) AddGitHubApp(app coolify_sdk.GitHubApp) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.storeGitHubApp(app)
+}
+
+// AddGitHubRepository makes a repository and its branches visible to the
+// GitHub App identified by appID.
+func (s *Server) AddGitHubRepository(appID int, repo coolify_sdk.GitHubRepository, branches ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if repo.ID == 0 {
+		repo.ID = s.newID()
+	}
+	if repo.FullName == "" {
+		repo.FullName = repo.Owner.Login + "/" + repo.Name
+	}
+	s.githubRepositories[appID] = append(s.githubRepositories[appID], repo)
+
+	for _, name := range branches {
+		branch := coolify_sdk.GitHubBranch{Name: name}
+		branch.Commit.SHA = newUUID()
+		s.githubBranches[repo.FullName] = append(s.githubBranches[repo.FullName], branch)
+	}
+}
+
+func (s *Server) storeGitHubApp(app coolify_sdk.GitHubApp) int {
+	if app.ID == 0 {
+		app.ID = s.newID()
+	}
+	if app.UUID == "" {
+		app.UUID = newUUID()
+	}
+	if app.CustomUser == "" {
+		app.CustomUser = "git"
+	}
+	if app.CustomPort == 0 {
+		app.CustomPort = 22
+	}
+	if app.CreatedAt.IsZero() {
+		app.CreatedAt = client.NewTimestamp(now())
+		app.UpdatedAt = app.CreatedAt
+	}
+
+	s.githubApps[app.ID] = &app
+	return app.ID
+}
+
+func (s *Server) githubAppRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/github-apps", s.authorized(s.listGitHubApps, false))
+	mux.HandleFunc("POST /api/v1/github-apps", s.authorized(s.createGitHubApp, false))
+	mux.HandleFunc("GET /api/v1/github-apps/{id}", s.authorized(s.getGitHubApp, false))
+	mux.HandleFunc("PATCH /api/v1/github-apps/{id}", s.authorized(s.updateGitHubApp, false))
+	mux.HandleFunc("DELETE /api/v1/github-apps/{id}", s.authorized(s.deleteGitHubApp, false))
+	mux.HandleFunc("GET /api/v1/github-apps/{id}/repositories", s.authorized(s.listGitHubRepositories, false))
+	mux.HandleFunc("GET /api/v1/github-apps/{id}/repositories/{owner}/{repo}/branches", s.authorized(s.listGitHubBranches, false))
+}
+
+func (s *Server) findGitHubApp(w http.ResponseWriter, r *http.Request) (*coolify_sdk.GitHubApp, bool) {
+	id, _ := strconv.Atoi(r.PathValue("id"))
+	app, ok := s.githubApps[id]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "GitHub App not found.")
+	}
+	return app, ok
+}
+
+// resolvePrivateKey replaces private_key_uuid in body with the key's ID.
+func (s *Server) resolvePrivateKey(w http.ResponseWriter, body map[string]any) bool {
+	if _, ok := body["private_key_uuid"]; !ok {
+		return true
+	}
+
+	key, ok := s.privateKeys[stringField(body, "private_key_uuid")]
+	delete(body, "private_key_uuid")
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Private Key not found.")
+		return false
+	}
+	body["private_key_id"] = key.ID
+	return true
+}
+
+func (s *Server) listGitHubApps(w http.ResponseWriter, r *http.Request) {
+	apps := make([]coolify_sdk.GitHubApp, 0, len(s.githubApps))
+	for _, app := range s.githubApps {
+		apps = append(apps, *app)
+	}
+	slices.SortFunc(apps, func(a, b coolify_sdk.GitHubApp) int { return a.ID - b.ID })
+
+	writeJSON(w, http.StatusOK, apps)
+}
+
+func (s *Server) getGitHubApp(w http.ResponseWriter, r *http.Request) {
+	if app, ok := s.findGitHubApp(w, r); ok {
+		writeJSON(w, http.StatusOK, app)
+	}
+}
+
+func (s *Server) createGitHubApp(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, jsonFields(coolify_sdk.CreateGitHubAppDTO{}))
+	errs.require(body, "name", "api_url", "html_url", "app_id", "installation_id", "client_id", "client_secret", "webhook_secret", "private_key_uuid")
+	if errs.write(w) {
+		return
+	}
+	if !s.resolvePrivateKey(w, body) {
+		return
+	}
+
+	app := coolify_sdk.GitHubApp{}
+	if err := merge(&app, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+
+	id := s.storeGitHubApp(app)
+	writeJSON(w, http.StatusCreated, s.githubApps[id])
+}
+
+func (s *Server) updateGitHubApp(w http.ResponseWriter, r *http.Request) {
+	app, ok := s.findGitHubApp(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, jsonFields(coolify_sdk.UpdateGitHubAppDTO{}))
+	if errs.write(w) {
+		return
+	}
+	if !s.resolvePrivateKey(w, body) {
+		return
+	}
+
+	if err := merge(app, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+	app.UpdatedAt = client.NewTimestamp(now())
+
+	writeMessage(w, http.StatusOK, "GitHub App updated.")
+}
+
+func (s *Server) deleteGitHubApp(w http.ResponseWriter, r *http.Request) {
+	app, ok := s.findGitHubApp(w, r)
+	if !ok {
+		return
+	}
+
+	for _, repo := range s.githubRepositories[app.ID] {
+		delete(s.githubBranches, repo.FullName)
+	}
+	delete(s.githubRepositories, app.ID)
+	delete(s.githubApps, app.ID)
+	writeMessage(w, http.StatusOK, "GitHub App deleted.")
+}
+
+func (s *Server) listGitHubRepositories(w http.ResponseWriter, r *http.Request) {
+	app, ok := s.findGitHubApp(w, r)
+	if !ok {
+		return
+	}
+
+	repositories := append([]coolify_sdk.GitHubRepository{}, s.githubRepositories[app.ID]...)
+	writeJSON(w, http.StatusOK, map[string]any{"repositories": repositories})
+}
+
+func (s *Server) listGitHubBranches(w http.ResponseWriter, r *http.Request) {
+	app, ok := s.findGitHubApp(w, r)
+	if !ok {
+		return
+	}
+
+	fullName := r.PathValue("owner") + "/" + r.PathValue("repo")
+	if !slices.ContainsFunc(s.githubRepositories[app.ID], func(repo coolify_sdk.GitHubRepository) bool {
+		return repo.FullName == fullName
+	}) {
+		writeMessage(w, http.StatusNotFound, "Repository not found.")
+		return
+	}
+
+	branches := append([]coolify_sdk.GitHubBranch{}, s.githubBranches[fullName]...)
+	writeJSON(w, http.StatusOK, map[string]any{"branches": branches})
+}
@@ -0,0 +1,136 @@
+package coolifytest
+
+import (
+	"net/http"
+	"slices"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// AddPrivateKey seeds a private key and returns its UUID.
+func (s *Server) AddPrivateKey(key coolify_sdk.PrivateKey) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.storePrivateKey(key)
+}
+
+func (s *Server) storePrivateKey(key coolify_sdk.PrivateKey) string {
+	if key.UUID == "" {
+		key.UUID = newUUID()
+	}
+	if key.ID == 0 {
+		key.ID = s.newID()
+	}
+	if key.CreatedAt.IsZero() {
+		key.CreatedAt = client.NewTimestamp(now())
+		key.UpdatedAt = key.CreatedAt
+	}
+
+	s.privateKeys[key.UUID] = &key
+	return key.UUID
+}
+
+func (s *Server) privateKeyRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/security/keys", s.authorized(s.listPrivateKeys, false))
+	mux.HandleFunc("POST /api/v1/security/keys", s.authorized(s.createPrivateKey, false))
+	mux.HandleFunc("GET /api/v1/security/keys/{uuid}", s.authorized(s.getPrivateKey, false))
+	mux.HandleFunc("PATCH /api/v1/security/keys/{uuid}", s.authorized(s.updatePrivateKey, false))
+	mux.HandleFunc("DELETE /api/v1/security/keys/{uuid}", s.authorized(s.deletePrivateKey, false))
+}
+
+func (s *Server) findPrivateKey(w http.ResponseWriter, r *http.Request) (*coolify_sdk.PrivateKey, bool) {
+	key, ok := s.privateKeys[r.PathValue("uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Private Key not found.")
+	}
+	return key, ok
+}
+
+func (s *Server) listPrivateKeys(w http.ResponseWriter, r *http.Request) {
+	keys := make([]coolify_sdk.PrivateKey, 0, len(s.privateKeys))
+	for _, key := range s.privateKeys {
+		keys = append(keys, *key)
+	}
+	slices.SortFunc(keys, func(a, b coolify_sdk.PrivateKey) int { return a.ID - b.ID })
+
+	writeJSON(w, http.StatusOK, keys)
+}
+
+func (s *Server) getPrivateKey(w http.ResponseWriter, r *http.Request) {
+	if key, ok := s.findPrivateKey(w, r); ok {
+		writeJSON(w, http.StatusOK, key)
+	}
+}
+
+func (s *Server) createPrivateKey(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, []string{"name", "description", "private_key"})
+	errs.require(body, "private_key")
+	if errs.write(w) {
+		return
+	}
+
+	key := coolify_sdk.PrivateKey{}
+	if err := merge(&key, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]string{"uuid": s.storePrivateKey(key)})
+}
+
+func (s *Server) updatePrivateKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.findPrivateKey(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, []string{"name", "description", "private_key"})
+	if errs.write(w) {
+		return
+	}
+
+	if err := merge(key, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+	key.UpdatedAt = client.NewTimestamp(now())
+
+	writeJSON(w, http.StatusCreated, map[string]string{"uuid": key.UUID})
+}
+
+func (s *Server) deletePrivateKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.findPrivateKey(w, r)
+	if !ok {
+		return
+	}
+
+	for _, srv := range s.servers {
+		if srv.PrivateKeyID == key.ID {
+			writeMessage(w, http.StatusUnprocessableEntity, "Private Key is in use and cannot be deleted.")
+			return
+		}
+	}
+	for _, app := range s.githubApps {
+		if app.PrivateKeyID == key.ID {
+			writeMessage(w, http.StatusUnprocessableEntity, "Private Key is in use and cannot be deleted.")
+			return
+		}
+	}
+
+	delete(s.privateKeys, key.UUID)
+	writeMessage(w, http.StatusOK, "Private Key deleted.")
+}
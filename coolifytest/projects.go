@@ -0,0 +1,173 @@
+package coolifytest
+
+import (
+	"net/http"
+	"slices"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// AddProject seeds a project and returns its UUID. Projects without
+// environments get a "production" environment, as in Coolify.
+func (s *Server) AddProject(project coolify_sdk.Project) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.storeProject(project)
+}
+
+func (s *Server) storeProject(project coolify_sdk.Project) string {
+	if project.UUID == "" {
+		project.UUID = newUUID()
+	}
+	if project.ID == 0 {
+		project.ID = int64(s.newID())
+	}
+	if len(project.Environments) == 0 {
+		project.Environments = []coolify_sdk.Environment{{Name: "production"}}
+	}
+	for i := range project.Environments {
+		environment := &project.Environments[i]
+		if environment.ID == 0 {
+			environment.ID = int64(s.newID())
+		}
+		environment.ProjectId = project.ID
+		if environment.CreatedAt.IsZero() {
+			environment.CreatedAt = client.NewTimestamp(now())
+			environment.UpdatedAt = environment.CreatedAt
+		}
+	}
+
+	s.projects[project.UUID] = &project
+	return project.UUID
+}
+
+func (s *Server) projectRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/projects", s.authorized(s.listProjects, false))
+	mux.HandleFunc("POST /api/v1/projects", s.authorized(s.createProject, false))
+	mux.HandleFunc("GET /api/v1/projects/{uuid}", s.authorized(s.getProject, false))
+	mux.HandleFunc("PATCH /api/v1/projects/{uuid}", s.authorized(s.updateProject, false))
+	mux.HandleFunc("DELETE /api/v1/projects/{uuid}", s.authorized(s.deleteProject, false))
+	mux.HandleFunc("GET /api/v1/projects/{uuid}/{environment}/{$}", s.authorized(s.getProjectEnvironment, false))
+	mux.HandleFunc("GET /api/v1/projects/{uuid}/{environment}", s.authorized(s.getProjectEnvironment, false))
+}
+
+func (s *Server) findProject(w http.ResponseWriter, r *http.Request) (*coolify_sdk.Project, bool) {
+	project, ok := s.projects[r.PathValue("uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Project not found.")
+	}
+	return project, ok
+}
+
+func (s *Server) findEnvironment(project *coolify_sdk.Project, name string) *coolify_sdk.Environment {
+	for i := range project.Environments {
+		if project.Environments[i].Name == name {
+			return &project.Environments[i]
+		}
+	}
+	return nil
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	projects := make([]coolify_sdk.Project, 0, len(s.projects))
+	for _, project := range s.projects {
+		projects = append(projects, *project)
+	}
+	slices.SortFunc(projects, func(a, b coolify_sdk.Project) int { return int(a.ID - b.ID) })
+
+	writeJSON(w, http.StatusOK, projects)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	if project, ok := s.findProject(w, r); ok {
+		writeJSON(w, http.StatusOK, project)
+	}
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, []string{"name", "description"})
+	errs.require(body, "name")
+	if errs.write(w) {
+		return
+	}
+
+	project := coolify_sdk.Project{Name: stringField(body, "name")}
+	if description, ok := body["description"].(string); ok {
+		project.Description = &description
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]string{"uuid": s.storeProject(project)})
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, []string{"name", "description"})
+	if errs.write(w) {
+		return
+	}
+
+	if name, ok := body["name"].(string); ok {
+		project.Name = name
+	}
+	if description, ok := body["description"].(string); ok {
+		project.Description = &description
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"uuid":        project.UUID,
+		"name":        project.Name,
+		"description": project.Description,
+	})
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	for _, environment := range project.Environments {
+		for _, db := range s.databases {
+			if db.EnvironmentID == int(environment.ID) {
+				writeMessage(w, http.StatusBadRequest, "Project has resources, so it cannot be deleted.")
+				return
+			}
+		}
+	}
+
+	delete(s.projects, project.UUID)
+	writeMessage(w, http.StatusOK, "Project deleted.")
+}
+
+func (s *Server) getProjectEnvironment(w http.ResponseWriter, r *http.Request) {
+	project, ok := s.findProject(w, r)
+	if !ok {
+		return
+	}
+
+	environment := s.findEnvironment(project, r.PathValue("environment"))
+	if environment == nil {
+		writeMessage(w, http.StatusNotFound, "Environment not found.")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, environment)
+}
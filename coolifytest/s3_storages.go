@@ -0,0 +1,137 @@
+package coolifytest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/storage"
+)
+
+// AddS3Storage seeds an S3 storage and returns its UUID.
+func (s *Server) AddS3Storage(st storage.S3Storage) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.storeS3Storage(st)
+}
+
+func (s *Server) storeS3Storage(st storage.S3Storage) string {
+	if st.UUID == "" {
+		st.UUID = newUUID()
+	}
+	if st.ID == 0 {
+		st.ID = s.newID()
+	}
+	if st.CreatedAt.IsZero() {
+		st.CreatedAt = client.NewTimestamp(now())
+		st.UpdatedAt = st.CreatedAt
+	}
+
+	s.s3Storages[st.UUID] = &st
+	return st.UUID
+}
+
+func (s *Server) s3StorageRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/storages", s.authorized(s.listS3Storages, false))
+	mux.HandleFunc("POST /api/v1/storages", s.authorized(s.createS3Storage, false))
+	mux.HandleFunc("GET /api/v1/storages/{uuid}", s.authorized(s.getS3Storage, false))
+	mux.HandleFunc("PATCH /api/v1/storages/{uuid}", s.authorized(s.updateS3Storage, false))
+	mux.HandleFunc("DELETE /api/v1/storages/{uuid}", s.authorized(s.deleteS3Storage, false))
+	mux.HandleFunc("GET /api/v1/storages/{uuid}/test", s.authorized(s.testS3Storage, false))
+}
+
+func (s *Server) findS3Storage(w http.ResponseWriter, r *http.Request) (*storage.S3Storage, bool) {
+	st, ok := s.s3Storages[r.PathValue("uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Storage not found.")
+	}
+	return st, ok
+}
+
+func (s *Server) listS3Storages(w http.ResponseWriter, r *http.Request) {
+	storages := make([]storage.S3Storage, 0, len(s.s3Storages))
+	for _, st := range s.s3Storages {
+		storages = append(storages, *st)
+	}
+	slices.SortFunc(storages, func(a, b storage.S3Storage) int { return a.ID - b.ID })
+
+	writeJSON(w, http.StatusOK, storages)
+}
+
+func (s *Server) getS3Storage(w http.ResponseWriter, r *http.Request) {
+	if st, ok := s.findS3Storage(w, r); ok {
+		writeJSON(w, http.StatusOK, st)
+	}
+}
+
+func (s *Server) createS3Storage(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, jsonFields(storage.CreateS3StorageDTO{}))
+	errs.require(body, "name", "endpoint", "bucket", "region", "key", "secret")
+	if errs.write(w) {
+		return
+	}
+
+	st := storage.S3Storage{}
+	if err := merge(&st, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]string{"uuid": s.storeS3Storage(st)})
+}
+
+func (s *Server) updateS3Storage(w http.ResponseWriter, r *http.Request) {
+	st, ok := s.findS3Storage(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, jsonFields(storage.UpdateS3StorageDTO{}))
+	if errs.write(w) {
+		return
+	}
+
+	if err := merge(st, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+	st.IsUsable = false
+	st.UpdatedAt = client.NewTimestamp(now())
+
+	writeMessage(w, http.StatusOK, "Storage updated.")
+}
+
+func (s *Server) deleteS3Storage(w http.ResponseWriter, r *http.Request) {
+	st, ok := s.findS3Storage(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.s3Storages, st.UUID)
+	writeMessage(w, http.StatusOK, "Storage deleted.")
+}
+
+// testS3Storage marks the storage as usable. Use Inject to simulate an
+// unreachable bucket.
+func (s *Server) testS3Storage(w http.ResponseWriter, r *http.Request) {
+	st, ok := s.findS3Storage(w, r)
+	if !ok {
+		return
+	}
+
+	st.IsUsable = true
+	writeMessage(w, http.StatusOK, "Connection is working.")
+}
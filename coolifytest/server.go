@@ -0,0 +1,317 @@
+// Package coolifytest provides an in-memory fake of the Coolify v1 API for
+// tests that should not depend on a live Coolify instance.
+//
+//	fake := coolifytest.NewServer()
+//	defer fake.Close()
+//
+//	sdk := coolify_sdk.Init(fake.URL, fake.Token)
+package coolifytest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/database"
+	"github.com/marconneves/coolify-sdk-go/destination"
+	"github.com/marconneves/coolify-sdk-go/server"
+	"github.com/marconneves/coolify-sdk-go/storage"
+)
+
+// DefaultToken is the bearer token accepted by a Server unless WithToken is used.
+const DefaultToken = "coolifytest-token"
+
+// DefaultVersion is the Coolify version reported by a Server unless
+// WithVersion is used.
+const DefaultVersion = "4.0.0-beta.420"
+
+// Server is a fake Coolify instance backed by an httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	// Token is the bearer token the fake accepts.
+	Token string
+	// Version is the Coolify version the fake reports.
+	Version string
+
+	mu         sync.Mutex
+	apiEnabled bool
+	nextID     int
+	faults     []*Fault
+
+	teams        []coolify_sdk.Team
+	members      map[int][]coolify_sdk.Member
+	privateKeys  map[string]*coolify_sdk.PrivateKey
+	servers      map[string]*server.Server
+	destinations map[string]*destination.Destination
+	projects     map[string]*coolify_sdk.Project
+	databases    map[string]*database.Database
+	storages     map[string][]*storage.Storage
+	s3Storages   map[string]*storage.S3Storage
+
+	githubApps         map[int]*coolify_sdk.GitHubApp
+	githubRepositories map[int][]coolify_sdk.GitHubRepository
+	githubBranches     map[string][]coolify_sdk.GitHubBranch
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithToken sets the bearer token accepted by the fake.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.Token = token
+	}
+}
+
+// WithVersion sets the Coolify version reported by GET /version.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.Version = version
+	}
+}
+
+// NewServer starts a fake Coolify instance seeded with the root team.
+// Callers must Close it when done.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		Token:        DefaultToken,
+		Version:      DefaultVersion,
+		apiEnabled:   true,
+		nextID:       1,
+		members:      map[int][]coolify_sdk.Member{},
+		privateKeys:  map[string]*coolify_sdk.PrivateKey{},
+		servers:      map[string]*server.Server{},
+		destinations: map[string]*destination.Destination{},
+		projects:     map[string]*coolify_sdk.Project{},
+		databases:    map[string]*database.Database{},
+		storages:     map[string][]*storage.Storage{},
+		s3Storages:   map[string]*storage.S3Storage{},
+
+		githubApps:         map[int]*coolify_sdk.GitHubApp{},
+		githubRepositories: map[int][]coolify_sdk.GitHubRepository{},
+		githubBranches:     map[string][]coolify_sdk.GitHubBranch{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.AddTeam(coolify_sdk.Team{Id: 0, Name: "Root Team"}, coolify_sdk.Member{Id: 0, Name: "Root User", Email: "root@example.com"})
+
+	s.Server = httptest.NewServer(s.routes())
+	return s
+}
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v1/healthcheck", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("OK"))
+	})
+	mux.HandleFunc("GET /api/v1/version", s.authorized(s.version, false))
+	mux.HandleFunc("GET /api/v1/enable", s.authorized(s.enableAPI, true))
+	mux.HandleFunc("GET /api/v1/disable", s.authorized(s.disableAPI, true))
+
+	s.teamRoutes(mux)
+	s.privateKeyRoutes(mux)
+	s.githubAppRoutes(mux)
+	s.serverRoutes(mux)
+	s.projectRoutes(mux)
+	s.databaseRoutes(mux)
+	s.destinationRoutes(mux)
+	s.storageRoutes(mux)
+	s.s3StorageRoutes(mux)
+
+	return s.withFaults(mux)
+}
+
+// authorized rejects requests without the expected bearer token and, unless
+// always is set, requests made while the API is disabled. It also serialises
+// access to the in-memory state.
+func (s *Server) authorized(handler http.HandlerFunc, always bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || token == "" || token != s.Token {
+			writeMessage(w, http.StatusUnauthorized, "Unauthenticated.")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !always && !s.apiEnabled {
+			writeMessage(w, http.StatusForbidden, "You are not allowed to access the API.")
+			return
+		}
+
+		handler(w, r)
+	}
+}
+
+func (s *Server) version(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(s.Version))
+}
+
+func (s *Server) enableAPI(w http.ResponseWriter, r *http.Request) {
+	s.apiEnabled = true
+	writeMessage(w, http.StatusOK, "API Enabled.")
+}
+
+func (s *Server) disableAPI(w http.ResponseWriter, r *http.Request) {
+	s.apiEnabled = false
+	writeMessage(w, http.StatusOK, "API disabled.")
+}
+
+func (s *Server) newID() int {
+	id := s.nextID
+	s.nextID++
+	return id
+}
+
+const uuidAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// newUUID returns an identifier shaped like the cuid2 values Coolify uses.
+func newUUID() string {
+	buf := make([]byte, 24)
+	rand.Read(buf)
+	for i, b := range buf {
+		buf[i] = uuidAlphabet[int(b)%len(uuidAlphabet)]
+	}
+	return string(buf)
+}
+
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeMessage(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+// validationErrors mirrors Laravel's 422 response body.
+type validationErrors map[string][]string
+
+func (v validationErrors) add(field, message string) {
+	v[field] = append(v[field], message)
+}
+
+func (v validationErrors) require(body map[string]any, fields ...string) {
+	for _, field := range fields {
+		value, ok := body[field]
+		if !ok || value == nil || value == "" {
+			v.add(field, "The "+strings.ReplaceAll(field, "_", " ")+" field is required.")
+		}
+	}
+}
+
+// allow rejects fields not accepted by the endpoint, as Coolify does.
+func (v validationErrors) allow(body map[string]any, fields []string) {
+	for field := range body {
+		allowed := false
+		for _, f := range fields {
+			if f == field {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			v.add(field, "This field is not allowed.")
+		}
+	}
+}
+
+func (v validationErrors) write(w http.ResponseWriter) bool {
+	if len(v) == 0 {
+		return false
+	}
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]any{
+		"message": "Validation failed.",
+		"errors":  v,
+	})
+	return true
+}
+
+// readBody decodes a JSON request body into a map, writing a 400 response
+// when the body is not a JSON object.
+func readBody(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	body := map[string]any{}
+	if r.ContentLength == 0 {
+		return body, true
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return nil, false
+	}
+	return body, true
+}
+
+// merge applies body on top of target by round-tripping through JSON.
+func merge(target any, body map[string]any) error {
+	current, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]any{}
+	if err := json.Unmarshal(current, &fields); err != nil {
+		return err
+	}
+	for key, value := range body {
+		fields[key] = value
+	}
+
+	merged, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(merged, target)
+}
+
+// jsonFields returns the JSON field names declared by a struct value.
+func jsonFields(v any) []string {
+	t := reflect.TypeOf(v)
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func stringField(body map[string]any, field string) string {
+	value, _ := body[field].(string)
+	return value
+}
+
+func intField(body map[string]any, field string, fallback int) int {
+	switch value := body[field].(type) {
+	case float64:
+		return int(value)
+	case string:
+		if n, err := strconv.Atoi(value); err == nil {
+			return n
+		}
+	}
+	return fallback
+}
+
+func boolField(body map[string]any, field string) bool {
+	value, _ := body[field].(bool)
+	return value
+}
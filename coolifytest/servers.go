@@ -0,0 +1,273 @@
+package coolifytest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/destination"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+// AddServer seeds a server together with its default "coolify" network
+// destination and returns its UUID.
+func (s *Server) AddServer(srv server.Server) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.storeServer(srv)
+}
+
+func (s *Server) storeServer(srv server.Server) string {
+	if srv.UUID == "" {
+		srv.UUID = newUUID()
+	}
+	if srv.CreatedAt.IsZero() {
+		srv.CreatedAt = client.NewTimestamp(now())
+		srv.UpdatedAt = srv.CreatedAt
+	}
+	if srv.Proxy == nil {
+		srv.Proxy = &server.Proxy{Type: "traefik", Status: "running"}
+	}
+	if srv.Settings == nil {
+		srv.Settings = &server.Settings{
+			Id:                     s.newID(),
+			ConcurrentBuilds:       2,
+			DockerCleanupFrequency: "0 0 * * *",
+			DockerCleanupThreshold: 80,
+			DynamicTimeout:         3600,
+			IsReachable:            true,
+			IsUsable:               true,
+			ServerTimezone:         "UTC",
+			CreatedAt:              srv.CreatedAt,
+			UpdatedAt:              srv.CreatedAt,
+		}
+	}
+
+	if srv.Settings.ServerId == 0 {
+		srv.Settings.ServerId = s.newID()
+	}
+
+	s.servers[srv.UUID] = &srv
+
+	dest := &destination.Destination{
+		ID:        s.newID(),
+		UUID:      newUUID(),
+		Name:      "coolify",
+		Network:   "coolify",
+		Type:      destination.Standalone,
+		ServerID:  srv.Settings.ServerId,
+		CreatedAt: srv.CreatedAt,
+		UpdatedAt: srv.CreatedAt,
+	}
+	s.destinations[dest.UUID] = dest
+
+	return srv.UUID
+}
+
+func (s *Server) serverRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/servers", s.authorized(s.listServers, false))
+	mux.HandleFunc("POST /api/v1/servers", s.authorized(s.createServer, false))
+	mux.HandleFunc("GET /api/v1/servers/{uuid}", s.authorized(s.getServer, false))
+	mux.HandleFunc("PATCH /api/v1/servers/{uuid}", s.authorized(s.updateServer, false))
+	mux.HandleFunc("DELETE /api/v1/servers/{uuid}", s.authorized(s.deleteServer, false))
+	mux.HandleFunc("GET /api/v1/servers/{uuid}/resources", s.authorized(s.listServerResources, false))
+	mux.HandleFunc("GET /api/v1/servers/{uuid}/domains", s.authorized(s.listServerDomains, false))
+	mux.HandleFunc("GET /api/v1/servers/{uuid}/validate", s.authorized(s.validateServer, false))
+}
+
+func (s *Server) findServer(w http.ResponseWriter, r *http.Request) (*server.Server, bool) {
+	srv, ok := s.servers[r.PathValue("uuid")]
+	if !ok {
+		writeMessage(w, http.StatusNotFound, "Server not found.")
+	}
+	return srv, ok
+}
+
+func (s *Server) listServers(w http.ResponseWriter, r *http.Request) {
+	servers := make([]server.Server, 0, len(s.servers))
+	for _, srv := range s.servers {
+		servers = append(servers, *srv)
+	}
+	slices.SortFunc(servers, func(a, b server.Server) int { return a.CreatedAt.Compare(b.CreatedAt.Time) })
+
+	payload := make([]any, len(servers))
+	for i, srv := range servers {
+		payload[i] = laravelServer(srv)
+	}
+	writeJSON(w, http.StatusOK, payload)
+}
+
+func (s *Server) getServer(w http.ResponseWriter, r *http.Request) {
+	if srv, ok := s.findServer(w, r); ok {
+		writeJSON(w, http.StatusOK, laravelServer(*srv))
+	}
+}
+
+// laravelServer renders a server the way Coolify does, where a server
+// without proxy configuration has "proxy": [].
+func laravelServer(srv server.Server) any {
+	if srv.Proxy != nil {
+		return srv
+	}
+
+	data, err := json.Marshal(srv)
+	if err != nil {
+		return srv
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return srv
+	}
+	fields["proxy"] = []any{}
+	return fields
+}
+
+var serverFields = []string{"name", "description", "ip", "port", "user", "private_key_uuid", "is_build_server", "instant_validate"}
+
+// applyServerFields copies the create/update payload onto a server,
+// resolving private_key_uuid. It reports false after writing a 404.
+func (s *Server) applyServerFields(w http.ResponseWriter, srv *server.Server, body map[string]any) bool {
+	if uuid := stringField(body, "private_key_uuid"); uuid != "" {
+		key, ok := s.privateKeys[uuid]
+		if !ok {
+			writeMessage(w, http.StatusNotFound, "Private Key not found.")
+			return false
+		}
+		srv.PrivateKeyID = key.ID
+	}
+
+	if name, ok := body["name"].(string); ok {
+		srv.Name = name
+	}
+	if description, ok := body["description"].(string); ok {
+		srv.Description = &description
+	}
+	if ip, ok := body["ip"].(string); ok {
+		srv.IP = ip
+	}
+	if user, ok := body["user"].(string); ok {
+		srv.User = user
+	}
+	srv.Port = intField(body, "port", srv.Port)
+	if _, ok := body["is_build_server"]; ok && srv.Settings != nil {
+		srv.Settings.IsBuildServer = boolField(body, "is_build_server")
+	}
+
+	return true
+}
+
+func (s *Server) createServer(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, serverFields)
+	errs.require(body, "name", "ip", "private_key_uuid")
+	for _, srv := range s.servers {
+		if ip := stringField(body, "ip"); ip != "" && srv.IP == ip {
+			errs.add("ip", "The ip has already been taken.")
+		}
+	}
+	if errs.write(w) {
+		return
+	}
+
+	srv := server.Server{Port: 22, User: "root"}
+	if !s.applyServerFields(w, &srv, body) {
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]string{"uuid": s.storeServer(srv)})
+}
+
+func (s *Server) updateServer(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.findServer(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, serverFields)
+	if errs.write(w) {
+		return
+	}
+
+	if !s.applyServerFields(w, srv, body) {
+		return
+	}
+	srv.UpdatedAt = client.NewTimestamp(now())
+
+	writeJSON(w, http.StatusCreated, map[string]string{"uuid": srv.UUID})
+}
+
+func (s *Server) deleteServer(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.findServer(w, r)
+	if !ok {
+		return
+	}
+
+	if srv.IP == "host.docker.internal" {
+		writeMessage(w, http.StatusBadRequest, "Local server cannot be deleted.")
+		return
+	}
+	if len(s.serverDatabases(srv.UUID)) > 0 {
+		writeMessage(w, http.StatusUnprocessableEntity, "Server has resources, so you need to delete them before.")
+		return
+	}
+
+	for uuid, destination := range s.destinations {
+		if destination.ServerID == srv.Settings.ServerId {
+			delete(s.destinations, uuid)
+		}
+	}
+	delete(s.servers, srv.UUID)
+
+	writeMessage(w, http.StatusOK, "Server deleted.")
+}
+
+func (s *Server) listServerResources(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.findServer(w, r)
+	if !ok {
+		return
+	}
+
+	resources := []server.Resource{}
+	for _, db := range s.serverDatabases(srv.UUID) {
+		resources = append(resources, server.Resource{
+			UUID:   db.UUID,
+			Name:   db.Name,
+			Type:   db.DatabaseType,
+			Status: db.Status,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, resources)
+}
+
+func (s *Server) listServerDomains(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.findServer(w, r); ok {
+		writeJSON(w, http.StatusOK, []server.Domain{})
+	}
+}
+
+func (s *Server) validateServer(w http.ResponseWriter, r *http.Request) {
+	srv, ok := s.findServer(w, r)
+	if !ok {
+		return
+	}
+
+	if srv.Settings != nil {
+		srv.Settings.IsReachable = true
+		srv.Settings.IsUsable = true
+	}
+	writeMessage(w, http.StatusCreated, "Validation started.")
+}
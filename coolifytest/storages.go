@@ -0,0 +1,160 @@
+package coolifytest
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/storage"
+)
+
+func (s *Server) storageRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/databases/{uuid}/storages", s.authorized(s.listStorages, false))
+	mux.HandleFunc("POST /api/v1/databases/{uuid}/storages", s.authorized(s.createStorage, false))
+	mux.HandleFunc("PATCH /api/v1/databases/{uuid}/storages/{storage_uuid}", s.authorized(s.updateStorage, false))
+	mux.HandleFunc("DELETE /api/v1/databases/{uuid}/storages/{storage_uuid}", s.authorized(s.deleteStorage, false))
+}
+
+func (s *Server) findStorage(w http.ResponseWriter, r *http.Request) (*storage.Storage, bool) {
+	if _, ok := s.findDatabase(w, r); !ok {
+		return nil, false
+	}
+
+	for _, st := range s.storages[r.PathValue("uuid")] {
+		if st.UUID == r.PathValue("storage_uuid") {
+			return st, true
+		}
+	}
+
+	writeMessage(w, http.StatusNotFound, "Storage not found.")
+	return nil, false
+}
+
+func (s *Server) listStorages(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.findDatabase(w, r); !ok {
+		return
+	}
+
+	persistent, files := []storage.Storage{}, []storage.Storage{}
+	for _, st := range s.storages[r.PathValue("uuid")] {
+		item := *st
+		item.Type = ""
+		if st.Type == storage.File {
+			files = append(files, item)
+		} else {
+			persistent = append(persistent, item)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"persistent_storages": persistent,
+		"file_storages":       files,
+	})
+}
+
+func (s *Server) createStorage(w http.ResponseWriter, r *http.Request) {
+	db, ok := s.findDatabase(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, jsonFields(storage.CreateStorageDTO{}))
+	errs.require(body, "type", "mount_path")
+	switch storage.Type(stringField(body, "type")) {
+	case storage.Persistent:
+		errs.require(body, "name")
+	case storage.File:
+		if !boolField(body, "is_directory") {
+			errs.require(body, "content")
+		}
+	default:
+		errs.add("type", "The selected type is invalid.")
+	}
+	for _, st := range s.storages[db.UUID] {
+		if st.MountPath == stringField(body, "mount_path") {
+			errs.add("mount_path", "The mount path has already been taken.")
+		}
+	}
+	if errs.write(w) {
+		return
+	}
+
+	st := storage.Storage{
+		ID:           s.newID(),
+		UUID:         newUUID(),
+		ResourceType: "App\\Models\\" + resourceModel(db.DatabaseType),
+		CreatedAt:    client.NewTimestamp(now()),
+	}
+	st.UpdatedAt = st.CreatedAt
+	if err := merge(&st, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+	if st.Type == storage.File && st.FsPath == "" {
+		st.FsPath = "/data/coolify/databases/" + db.UUID + st.MountPath
+	}
+
+	s.storages[db.UUID] = append(s.storages[db.UUID], &st)
+	writeJSON(w, http.StatusCreated, map[string]string{"uuid": st.UUID})
+}
+
+func (s *Server) updateStorage(w http.ResponseWriter, r *http.Request) {
+	st, ok := s.findStorage(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	errs := validationErrors{}
+	errs.allow(body, jsonFields(storage.UpdateStorageDTO{}))
+	if errs.write(w) {
+		return
+	}
+
+	if err := merge(st, body); err != nil {
+		writeMessage(w, http.StatusBadRequest, "Invalid request.")
+		return
+	}
+	st.UpdatedAt = client.NewTimestamp(now())
+
+	writeMessage(w, http.StatusOK, "Storage updated.")
+}
+
+func (s *Server) deleteStorage(w http.ResponseWriter, r *http.Request) {
+	st, ok := s.findStorage(w, r)
+	if !ok {
+		return
+	}
+
+	uuid := r.PathValue("uuid")
+	s.storages[uuid] = slices.DeleteFunc(s.storages[uuid], func(item *storage.Storage) bool {
+		return item == st
+	})
+	writeMessage(w, http.StatusOK, "Storage deleted.")
+}
+
+// resourceModel maps a database type such as standalone-postgresql to its
+// Laravel model name.
+func resourceModel(databaseType string) string {
+	switch databaseType {
+	case "standalone-postgresql":
+		return "StandalonePostgresql"
+	case "standalone-mysql":
+		return "StandaloneMysql"
+	case "standalone-mariadb":
+		return "StandaloneMariadb"
+	case "standalone-redis":
+		return "StandaloneRedis"
+	}
+	return "Standalone"
+}
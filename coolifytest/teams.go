@@ -0,0 +1,72 @@
+package coolifytest
+
+import (
+	"net/http"
+	"strconv"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// AddTeam seeds a team and its members.
+func (s *Server) AddTeam(team coolify_sdk.Team, members ...coolify_sdk.Member) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if team.CreatedAt.IsZero() {
+		team.CreatedAt = client.NewTimestamp(now())
+		team.UpdatedAt = team.CreatedAt
+	}
+
+	s.teams = append(s.teams, team)
+	s.members[team.Id] = append(s.members[team.Id], members...)
+}
+
+func (s *Server) teamRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/v1/teams", s.authorized(s.listTeams, false))
+	mux.HandleFunc("GET /api/v1/teams/current", s.authorized(s.currentTeam, false))
+	mux.HandleFunc("GET /api/v1/teams/{id}", s.authorized(s.getTeam, false))
+	mux.HandleFunc("GET /api/v1/teams/{id}/members", s.authorized(s.listTeamMembers, false))
+}
+
+func (s *Server) findTeam(w http.ResponseWriter, r *http.Request) (*coolify_sdk.Team, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err == nil {
+		for i := range s.teams {
+			if s.teams[i].Id == id {
+				return &s.teams[i], true
+			}
+		}
+	}
+
+	writeMessage(w, http.StatusNotFound, "Team not found.")
+	return nil, false
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.teams)
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request) {
+	if team, ok := s.findTeam(w, r); ok {
+		writeJSON(w, http.StatusOK, team)
+	}
+}
+
+// currentTeam returns the root team, which the fake's token belongs to.
+func (s *Server) currentTeam(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.teams[0])
+}
+
+func (s *Server) listTeamMembers(w http.ResponseWriter, r *http.Request) {
+	team, ok := s.findTeam(w, r)
+	if !ok {
+		return
+	}
+
+	members := s.members[team.Id]
+	if members == nil {
+		members = []coolify_sdk.Member{}
+	}
+	writeJSON(w, http.StatusOK, members)
+}
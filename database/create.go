@@ -0,0 +1,154 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// CreateDatabaseDTO is implemented by the create DTOs of every database
+// type.
+type CreateDatabaseDTO interface {
+	// Target returns where the database is created and its name.
+	Target() Target
+	// UpdateDTO returns the DTO's fields that UpdateDatabaseDTO can also set.
+	UpdateDTO() *UpdateDatabaseDTO
+}
+
+// Target identifies a database by server, project, environment and name.
+type Target struct {
+	ServerUUID  string
+	ProjectUUID string
+	Environment string
+	Name        string
+	// Type is the database_type Coolify reports for the database, such as
+	// "standalone-postgresql".
+	Type string
+}
+
+// Create creates a database of the DTO's type.
+func (d *DatabaseInstance) Create(ctx context.Context, data CreateDatabaseDTO) (*string, error) {
+	switch dto := data.(type) {
+	case *CreateDatabasePostgresDTO:
+		return d.CreatePostgreSQL(ctx, dto)
+	case *CreateDatabaseMySQLDTO:
+		return d.CreateMySQL(ctx, dto)
+	case *CreateDatabaseMariaDBDTO:
+		return d.CreateMariaDB(ctx, dto)
+	case *CreateDatabaseRedisDTO:
+		return d.CreateRedis(ctx, dto)
+	}
+
+	return nil, fmt.Errorf("unsupported database DTO %T", data)
+}
+
+// Target implements CreateDatabaseDTO.
+func (d *CreateDatabasePostgresDTO) Target() Target {
+	return Target{ServerUUID: d.ServerUUID, ProjectUUID: d.ProjectUUID, Environment: d.Environment, Name: client.Deref(d.Name), Type: "standalone-postgresql"}
+}
+
+// UpdateDTO implements CreateDatabaseDTO.
+func (d *CreateDatabasePostgresDTO) UpdateDTO() *UpdateDatabaseDTO {
+	return &UpdateDatabaseDTO{
+		Name:                    d.Name,
+		Description:             d.Description,
+		Image:                   d.Image,
+		IsPublic:                d.IsPublic,
+		PublicPort:              d.PublicPort,
+		PostgresUser:            d.PostgresUser,
+		PostgresPassword:        d.PostgresPassword,
+		PostgresDB:              d.PostgresDB,
+		PostgresInitdbArgs:      d.PostgresInitdbArgs,
+		PostgresHostAuthMethod:  d.PostgresHostAuthMethod,
+		PostgresConf:            d.PostgresConf,
+		LimitsMemory:            d.LimitsMemory,
+		LimitsMemorySwap:        d.LimitsMemorySwap,
+		LimitsMemorySwappiness:  d.LimitsMemorySwappiness,
+		LimitsMemoryReservation: d.LimitsMemoryReservation,
+		LimitsCpus:              d.LimitsCPUs,
+		LimitsCpuset:            d.LimitsCPUSet,
+		LimitsCPUShares:         d.LimitsCPUShares,
+	}
+}
+
+// Target implements CreateDatabaseDTO.
+func (d *CreateDatabaseMySQLDTO) Target() Target {
+	return Target{ServerUUID: d.ServerUUID, ProjectUUID: d.ProjectUUID, Environment: d.EnvironmentName, Name: client.Deref(d.Name), Type: "standalone-mysql"}
+}
+
+// UpdateDTO implements CreateDatabaseDTO.
+func (d *CreateDatabaseMySQLDTO) UpdateDTO() *UpdateDatabaseDTO {
+	return &UpdateDatabaseDTO{
+		Name:                    d.Name,
+		Description:             d.Description,
+		Image:                   d.Image,
+		IsPublic:                d.IsPublic,
+		PublicPort:              d.PublicPort,
+		MysqlRootPassword:       d.MysqlRootPassword,
+		MysqlPassword:           d.MysqlPassword,
+		MysqlUser:               d.MysqlUser,
+		MysqlDatabase:           d.MysqlDatabase,
+		MysqlConf:               d.MysqlConf,
+		LimitsMemory:            d.LimitsMemory,
+		LimitsMemorySwap:        d.LimitsMemorySwap,
+		LimitsMemorySwappiness:  d.LimitsMemorySwappiness,
+		LimitsMemoryReservation: d.LimitsMemoryReservation,
+		LimitsCpus:              d.LimitsCPUs,
+		LimitsCpuset:            d.LimitsCPUSet,
+		LimitsCPUShares:         d.LimitsCPUShares,
+	}
+}
+
+// Target implements CreateDatabaseDTO.
+func (d *CreateDatabaseMariaDBDTO) Target() Target {
+	return Target{ServerUUID: d.ServerUUID, ProjectUUID: d.ProjectUUID, Environment: d.EnvironmentName, Name: client.Deref(d.Name), Type: "standalone-mariadb"}
+}
+
+// UpdateDTO implements CreateDatabaseDTO.
+func (d *CreateDatabaseMariaDBDTO) UpdateDTO() *UpdateDatabaseDTO {
+	return &UpdateDatabaseDTO{
+		Name:                    d.Name,
+		Description:             d.Description,
+		Image:                   d.Image,
+		IsPublic:                d.IsPublic,
+		PublicPort:              d.PublicPort,
+		MariadbConf:             d.MariadbConf,
+		MariadbRootPassword:     d.MariadbRootPassword,
+		MariadbUser:             d.MariadbUser,
+		MariadbPassword:         d.MariadbPassword,
+		MariadbDatabase:         d.MariadbDatabase,
+		LimitsMemory:            d.LimitsMemory,
+		LimitsMemorySwap:        d.LimitsMemorySwap,
+		LimitsMemorySwappiness:  d.LimitsMemorySwappiness,
+		LimitsMemoryReservation: d.LimitsMemoryReservation,
+		LimitsCpus:              d.LimitsCPUs,
+		LimitsCpuset:            d.LimitsCPUSet,
+		LimitsCPUShares:         d.LimitsCPUShares,
+	}
+}
+
+// Target implements CreateDatabaseDTO.
+func (d *CreateDatabaseRedisDTO) Target() Target {
+	return Target{ServerUUID: d.ServerUUID, ProjectUUID: d.ProjectUUID, Environment: d.EnvironmentName, Name: client.Deref(d.Name), Type: "standalone-redis"}
+}
+
+// UpdateDTO implements CreateDatabaseDTO.
+func (d *CreateDatabaseRedisDTO) UpdateDTO() *UpdateDatabaseDTO {
+	return &UpdateDatabaseDTO{
+		Name:                    d.Name,
+		Description:             d.Description,
+		Image:                   d.Image,
+		IsPublic:                d.IsPublic,
+		PublicPort:              d.PublicPort,
+		RedisPassword:           d.RedisPassword,
+		RedisConf:               d.RedisConf,
+		LimitsMemory:            d.LimitsMemory,
+		LimitsMemorySwap:        d.LimitsMemorySwap,
+		LimitsMemorySwappiness:  d.LimitsMemorySwappiness,
+		LimitsMemoryReservation: d.LimitsMemoryReservation,
+		LimitsCpus:              d.LimitsCPUs,
+		LimitsCpuset:            d.LimitsCPUSet,
+		LimitsCPUShares:         d.LimitsCPUShares,
+	}
+}
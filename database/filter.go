@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// Tag is a label attached to a resource in Coolify.
+type Tag struct {
+	ID     int    `json:"id"`
+	UUID   string `json:"uuid"`
+	Name   string `json:"name"`
+	TeamID int    `json:"team_id"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Filter narrows the databases yielded by All. Empty fields match
+// everything. Coolify cannot filter databases server-side, so every field is
+// applied on the client.
+type Filter struct {
+	ProjectUUID string
+	// Environment is an environment name. Without ProjectUUID it matches
+	// the environment of that name in every project.
+	Environment string
+	ServerUUID  string
+	// Status matches the full status ("running:healthy") or its state
+	// ("running").
+	Status string
+	// Tag matches a tag name.
+	Tag string
+}
+
+// All iterates over the databases matching filter.
+func (d *DatabaseInstance) All(ctx context.Context, filter Filter) iter.Seq2[Database, error] {
+	return func(yield func(Database, error) bool) {
+		environments, err := d.environments(ctx, filter)
+		if err != nil {
+			yield(Database{}, err)
+			return
+		}
+
+		keep := func(db Database) bool {
+			return filter.matches(db, environments)
+		}
+
+		for db, err := range client.Iterate(ctx, d.client, "databases", keep) {
+			if err != nil {
+				err = fmt.Errorf("failed to list databases: %w", err)
+			}
+			if !yield(db, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (f Filter) matches(db Database, environments map[int]bool) bool {
+	if environments != nil && !environments[db.EnvironmentID] {
+		return false
+	}
+
+	if f.ServerUUID != "" && db.Destination.Server.UUID != f.ServerUUID {
+		return false
+	}
+
+	if f.Status != "" {
+		if db.Status != f.Status && string(ParseStatus(db.Status).State) != f.Status {
+			return false
+		}
+	}
+
+	if f.Tag != "" {
+		tagged := false
+		for _, tag := range db.Tags {
+			tagged = tagged || tag.Name == f.Tag
+		}
+		if !tagged {
+			return false
+		}
+	}
+
+	return true
+}
+
+// environments returns the IDs of the environments selected by the filter,
+// or nil when it does not filter by project or environment.
+func (d *DatabaseInstance) environments(ctx context.Context, filter Filter) (map[int]bool, error) {
+	if filter.ProjectUUID == "" && filter.Environment == "" {
+		return nil, nil
+	}
+
+	type project struct {
+		UUID         string `json:"uuid"`
+		Environments []struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"environments"`
+	}
+
+	uuids := []string{filter.ProjectUUID}
+	if filter.ProjectUUID == "" {
+		body, err := d.client.HttpRequestWithContext(ctx, "projects", "GET")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		defer body.Close()
+
+		projects, err := client.DecodeResponse(body, &[]project{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode projects list: %w", err)
+		}
+
+		uuids = uuids[:0]
+		for _, p := range *projects {
+			uuids = append(uuids, p.UUID)
+		}
+	}
+
+	environments := map[int]bool{}
+	for _, uuid := range uuids {
+		body, err := d.client.HttpRequestWithContext(ctx, fmt.Sprintf("projects/%v", uuid), "GET")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project %s: %w", uuid, err)
+		}
+
+		p, err := client.DecodeResponse(body, &project{})
+		body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode project %s: %w", uuid, err)
+		}
+
+		for _, environment := range p.Environments {
+			if filter.Environment == "" || environment.Name == filter.Environment {
+				environments[environment.ID] = true
+			}
+		}
+	}
+
+	return environments, nil
+}
@@ -1,62 +1,62 @@
-package database
-
-import (
-	"context"
-	"fmt"
-
-	"github.com/marconneves/coolify-sdk-go/client"
-)
-
-// CreateDatabasePostgresDTO represents the data required to create a PostgreSQL database.
-type CreateDatabasePostgresDTO struct {
-	ServerUUID      string  `json:"server_uuid"`
-	ProjectUUID     string  `json:"project_uuid"`
-	Environment     string  `json:"environment_name"`
-	Name            *string `json:"name,omitempty"`
-	Description     *string `json:"description,omitempty"`
-	Image           *string `json:"image,omitempty"`
-	IsPublic        *bool   `json:"is_public,omitempty"`
-	PublicPort      *int    `json:"public_port,omitempty"`
-	InstantDeploy   *bool   `json:"instant_deploy,omitempty"`
-	DestinationUUID *string `json:"destination_uuid,omitempty"`
-
-	PostgresUser           *string `json:"postgres_user,omitempty"`
-	PostgresPassword       *string `json:"postgres_password,omitempty"`
-	PostgresDB             *string `json:"postgres_db,omitempty"`
-	PostgresInitdbArgs     *string `json:"postgres_initdb_args,omitempty"`
-	PostgresHostAuthMethod *string `json:"postgres_host_auth_method,omitempty"`
-	PostgresConf           *string `json:"postgres_conf,omitempty"`
-
-	LimitsMemory            *string `json:"limits_memory,omitempty"`
-	LimitsMemorySwap        *string `json:"limits_memory_swap,omitempty"`
-	LimitsMemorySwappiness  *int    `json:"limits_memory_swappiness,omitempty"`
-	LimitsMemoryReservation *string `json:"limits_memory_reservation,omitempty"`
-	LimitsCPUs              *string `json:"limits_cpus,omitempty"`
-	LimitsCPUSet            *string `json:"limits_cpuset,omitempty"`
-	LimitsCPUShares         *int    `json:"limits_cpu_shares,omitempty"`
-}
-
-// CreateDatabasePostgresResponse represents the response when creating a PostgreSQL database.
-type CreateDatabasePostgresResponse struct {
-	UUID string `json:"uuid"`
-}
-
-// CreatePostgreSQL creates a new PostgreSQL database instance.
-func (d *DatabaseInstance) CreatePostgreSQL(ctx context.Context, data *CreateDatabasePostgresDTO) (*string, error) {
-	buf, err := client.EncodeRequest(data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode request: %w", err)
-	}
-
-	body, err := d.client.HttpRequestWithContext(ctx, "databases/postgresql", "POST", *buf)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create PostgreSQL database: %w", err)
-	}
-
-	response, err := client.DecodeResponse(body, &CreateDatabasePostgresResponse{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &response.UUID, nil
-}
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// CreateDatabasePostgresDTO represents the data required to create a PostgreSQL database.
+type CreateDatabasePostgresDTO struct {
+	ServerUUID      string  `json:"server_uuid"`
+	ProjectUUID     string  `json:"project_uuid"`
+	Environment     string  `json:"environment_name"`
+	Name            *string `json:"name,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	Image           *string `json:"image,omitempty"`
+	IsPublic        *bool   `json:"is_public,omitempty"`
+	PublicPort      *int    `json:"public_port,omitempty"`
+	InstantDeploy   *bool   `json:"instant_deploy,omitempty"`
+	DestinationUUID *string `json:"destination_uuid,omitempty"`
+
+	PostgresUser           *string `json:"postgres_user,omitempty"`
+	PostgresPassword       *string `json:"postgres_password,omitempty"`
+	PostgresDB             *string `json:"postgres_db,omitempty"`
+	PostgresInitdbArgs     *string `json:"postgres_initdb_args,omitempty"`
+	PostgresHostAuthMethod *string `json:"postgres_host_auth_method,omitempty"`
+	PostgresConf           *string `json:"postgres_conf,omitempty"`
+
+	LimitsMemory            *string `json:"limits_memory,omitempty"`
+	LimitsMemorySwap        *string `json:"limits_memory_swap,omitempty"`
+	LimitsMemorySwappiness  *int    `json:"limits_memory_swappiness,omitempty"`
+	LimitsMemoryReservation *string `json:"limits_memory_reservation,omitempty"`
+	LimitsCPUs              *string `json:"limits_cpus,omitempty"`
+	LimitsCPUSet            *string `json:"limits_cpuset,omitempty"`
+	LimitsCPUShares         *int    `json:"limits_cpu_shares,omitempty"`
+}
+
+// CreateDatabasePostgresResponse represents the response when creating a PostgreSQL database.
+type CreateDatabasePostgresResponse struct {
+	UUID string `json:"uuid"`
+}
+
+// CreatePostgreSQL creates a new PostgreSQL database instance.
+func (d *DatabaseInstance) CreatePostgreSQL(ctx context.Context, data *CreateDatabasePostgresDTO) (*string, error) {
+	buf, err := client.EncodeRequest(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	body, err := d.client.HttpRequestWithContext(ctx, "databases/postgresql", "POST", *buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PostgreSQL database: %w", err)
+	}
+
+	response, err := client.DecodeResponse(body, &CreateDatabasePostgresResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response.UUID, nil
+}
@@ -0,0 +1,198 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// State is the container state part of a database status.
+type State string
+
+const (
+	StateRunning    State = "running"
+	StateStarting   State = "starting"
+	StateRestarting State = "restarting"
+	StateDegraded   State = "degraded"
+	StateExited     State = "exited"
+)
+
+// Health is the health check part of a database status.
+type Health string
+
+const (
+	HealthHealthy   Health = "healthy"
+	HealthUnhealthy Health = "unhealthy"
+	HealthUnknown   Health = "unknown"
+)
+
+// Status is a parsed database status such as "running:healthy".
+type Status struct {
+	State  State
+	Health Health
+}
+
+var (
+	StatusHealthy = Status{State: StateRunning, Health: HealthHealthy}
+	StatusExited  = Status{State: StateExited}
+)
+
+// ready reports whether s is a started database: running and healthy, or
+// running without a healthcheck, which Coolify reports as unknown.
+func (s Status) ready() bool {
+	return s.State == StateRunning && (s.Health == HealthHealthy || s.Health == HealthUnknown)
+}
+
+// ErrWaitTimeout is returned by WaitForStatus when the database does not
+// reach the desired status before WaitOptions.Timeout.
+var ErrWaitTimeout = errors.New("timed out waiting for database status")
+
+// DefaultWaitInterval is the polling interval used when
+// WaitOptions.Interval is zero.
+const DefaultWaitInterval = 2 * time.Second
+
+// ParseStatus parses a status returned by Coolify. A status without a
+// health part, such as "exited", has an empty Health.
+func ParseStatus(status string) Status {
+	state, health, _ := strings.Cut(strings.TrimSpace(status), ":")
+	return Status{State: State(state), Health: Health(health)}
+}
+
+// String formats s the way Coolify does.
+func (s Status) String() string {
+	if s.Health == "" {
+		return string(s.State)
+	}
+	return string(s.State) + ":" + string(s.Health)
+}
+
+// Matches reports whether s satisfies desired. An empty Health in desired
+// matches any health.
+func (s Status) Matches(desired Status) bool {
+	if s.State != desired.State {
+		return false
+	}
+	return desired.Health == "" || s.Health == desired.Health
+}
+
+// WaitOptions configures WaitForStatus.
+type WaitOptions struct {
+	// Interval between polls. Defaults to DefaultWaitInterval.
+	Interval time.Duration
+	// Timeout bounds the wait. Zero waits until ctx is done.
+	Timeout time.Duration
+}
+
+// WaitForStatus polls the database until its status matches desired.
+func (d *DatabaseInstance) WaitForStatus(ctx context.Context, uuid string, desired Status, opts WaitOptions) error {
+	return d.waitFor(ctx, uuid, desired, opts, func(db *Database) bool {
+		return ParseStatus(db.Status).Matches(desired)
+	})
+}
+
+// waitFor polls the database until done returns true. desired is only used
+// to describe the timeout.
+func (d *DatabaseInstance) waitFor(ctx context.Context, uuid string, desired Status, opts WaitOptions, done func(*Database) bool) error {
+	if uuid == "" {
+		return errors.New("UUID is required")
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+
+	var deadline <-chan time.Time
+	if opts.Timeout > 0 {
+		timer := time.NewTimer(opts.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		db, err := d.poll(ctx, uuid)
+		if err != nil {
+			return err
+		}
+		if done(db) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline:
+			return fmt.Errorf("%w: database %s is %s, want %s", ErrWaitTimeout, uuid, ParseStatus(db.Status), desired)
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll fetches a database bypassing the client's cache, so that every poll
+// sees the current status.
+func (d *DatabaseInstance) poll(ctx context.Context, uuid string) (*Database, error) {
+	body, err := d.client.Probe(ctx, fmt.Sprintf("databases/%v", uuid))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database %s: %w", uuid, err)
+	}
+
+	res, err := client.DecodeResponse(body, &Database{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode database %s: %w", uuid, err)
+	}
+
+	return res, nil
+}
+
+// StartAndWait starts a database and waits until it is running and healthy,
+// or running with an unknown health for databases without a healthcheck.
+func (d *DatabaseInstance) StartAndWait(ctx context.Context, uuid string, opts WaitOptions) error {
+	if err := d.Start(ctx, uuid); err != nil {
+		return err
+	}
+	return d.waitFor(ctx, uuid, StatusHealthy, opts, func(db *Database) bool {
+		return ParseStatus(db.Status).ready()
+	})
+}
+
+// StopAndWait stops a database and waits until it has exited.
+func (d *DatabaseInstance) StopAndWait(ctx context.Context, uuid string, opts WaitOptions) error {
+	if err := d.Stop(ctx, uuid); err != nil {
+		return err
+	}
+	return d.WaitForStatus(ctx, uuid, StatusExited, opts)
+}
+
+// RestartAndWait restarts a database and waits until it is running and
+// healthy again, or running with an unknown health like StartAndWait. Coolify queues the restart and keeps reporting the previous
+// status for a while, so the wait only ends once the database has left the
+// healthy status or its StartedAt has moved past the value it had before the
+// restart.
+func (d *DatabaseInstance) RestartAndWait(ctx context.Context, uuid string, opts WaitOptions) error {
+	if uuid == "" {
+		return errors.New("UUID is required")
+	}
+	before, err := d.poll(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	if err := d.Restart(ctx, uuid); err != nil {
+		return err
+	}
+
+	restarted := false
+	return d.waitFor(ctx, uuid, StatusHealthy, opts, func(db *Database) bool {
+		ready := ParseStatus(db.Status).ready()
+		if !ready || db.StartedAt.After(before.StartedAt.Time) {
+			restarted = true
+		}
+		return restarted && ready
+	})
+}
@@ -0,0 +1,176 @@
+// Package destination manages the Docker networks resources are deployed to.
+package destination
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+// ErrNoDefaultDestination is returned by Default when a server has no
+// destination, or several and none of them on a default network.
+var ErrNoDefaultDestination = errors.New("no default destination")
+
+// DefaultNetworks are the networks Coolify creates when it sets up a
+// standalone or a Swarm server.
+var DefaultNetworks = []string{"coolify", "coolify-overlay"}
+
+// Type distinguishes standalone Docker networks from Swarm overlay networks.
+type Type string
+
+const (
+	Standalone Type = "standalone"
+	Swarm      Type = "swarm"
+)
+
+// DestinationInstance provides methods to manage destinations.
+type DestinationInstance struct {
+	client *client.Client
+}
+
+// NewDestinationInstance creates a new DestinationInstance.
+func NewDestinationInstance(client *client.Client) *DestinationInstance {
+	return &DestinationInstance{client: client}
+}
+
+// Destination is a Docker network on a server. Server is only populated when
+// the destination is embedded in another resource, such as a database.
+type Destination struct {
+	CreatedAt client.Timestamp `json:"created_at"`
+	ID        int              `json:"id"`
+	Name      string           `json:"name"`
+	Network   string           `json:"network"`
+	Type      Type             `json:"type"`
+	Server    server.Server    `json:"server"`
+	ServerID  int              `json:"server_id"`
+	UpdatedAt client.Timestamp `json:"updated_at"`
+	UUID      string           `json:"uuid"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CreateDestinationDTO represents the data required to create a destination.
+// Type defaults to Standalone.
+type CreateDestinationDTO struct {
+	ServerUUID string `json:"server_uuid"`
+	Name       string `json:"name"`
+	Network    string `json:"network"`
+	Type       Type   `json:"type,omitempty"`
+}
+
+// CreateDestinationResponse represents the response when creating a
+// destination.
+type CreateDestinationResponse struct {
+	UUID string `json:"uuid"`
+}
+
+// List retrieves the destinations of a server.
+func (d *DestinationInstance) List(ctx context.Context, serverUUID string) (*[]Destination, error) {
+	if serverUUID == "" {
+		return nil, errors.New("server UUID is required")
+	}
+
+	body, err := d.client.HttpRequestWithContext(ctx, "destinations?server_uuid="+url.QueryEscape(serverUUID), "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list destinations of server %s: %w", serverUUID, err)
+	}
+
+	res, err := client.DecodeResponse(body, &[]Destination{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode destinations list: %w", err)
+	}
+
+	return res, nil
+}
+
+// Get retrieves a destination by UUID.
+func (d *DestinationInstance) Get(ctx context.Context, uuid string) (*Destination, error) {
+	if uuid == "" {
+		return nil, errors.New("UUID is required")
+	}
+
+	body, err := d.client.HttpRequestWithContext(ctx, fmt.Sprintf("destinations/%v", uuid), "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination %s: %w", uuid, err)
+	}
+
+	res, err := client.DecodeResponse(body, &Destination{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode destination %s: %w", uuid, err)
+	}
+
+	return res, nil
+}
+
+// Default returns the only destination of a server or, when it has several,
+// the one on a default network. Coolify itself refuses to pick a destination
+// for servers with several, so pass the returned UUID as DestinationUUID.
+func (d *DestinationInstance) Default(ctx context.Context, serverUUID string) (*Destination, error) {
+	destinations, err := d.List(ctx, serverUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(*destinations) == 1 {
+		return &(*destinations)[0], nil
+	}
+
+	for _, destination := range *destinations {
+		if slices.Contains(DefaultNetworks, destination.Network) {
+			return &destination, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: server %s has %d destinations", ErrNoDefaultDestination, serverUUID, len(*destinations))
+}
+
+// Create creates a destination on a server and returns its UUID.
+func (d *DestinationInstance) Create(ctx context.Context, data *CreateDestinationDTO) (*string, error) {
+	switch {
+	case data.ServerUUID == "":
+		return nil, errors.New("server UUID is required")
+	case data.Network == "":
+		return nil, errors.New("network is required")
+	case data.Type != "" && data.Type != Standalone && data.Type != Swarm:
+		return nil, fmt.Errorf("unknown destination type %q", data.Type)
+	}
+
+	buf, err := client.EncodeRequest(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	body, err := d.client.HttpRequestWithContext(ctx, "destinations", "POST", *buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create destination: %w", err)
+	}
+
+	response, err := client.DecodeResponse(body, &CreateDestinationResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response.UUID, nil
+}
+
+// Delete removes a destination. Coolify refuses to delete destinations that
+// still have resources.
+func (d *DestinationInstance) Delete(ctx context.Context, uuid string) error {
+	if uuid == "" {
+		return errors.New("UUID is required")
+	}
+
+	body, err := d.client.HttpRequestWithContext(ctx, fmt.Sprintf("destinations/%v", uuid), "DELETE")
+	if err != nil {
+		return fmt.Errorf("failed to delete destination %s: %w", uuid, err)
+	}
+	body.Close()
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package coolify_sdk
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+)
+
+// DefaultDriftIgnore lists the volatile fields that DetectDrift ignores
+// unless DriftOptions.Ignore is set.
+var DefaultDriftIgnore = []string{
+	"UpdatedAt",
+	"Status",
+	"StartedAt",
+	"ServerStatus",
+	"UnreachableCount",
+	"UnreachableNotificationSent",
+	"IsReachable",
+	"IsUsable",
+}
+
+// DriftOptions controls DetectDrift.
+type DriftOptions struct {
+	// Ignore holds patterns matched with path.Match against both the full
+	// field path (e.g. "Server.Settings.ConcurrentBuilds") and the field
+	// name (e.g. "UpdatedAt"). Defaults to DefaultDriftIgnore; use
+	// append(DefaultDriftIgnore, ...) to extend it.
+	Ignore []string
+	// IncludeSecrets must match the ExportOptions the baseline was exported
+	// with, otherwise every secret shows up as drift.
+	IncludeSecrets bool
+}
+
+// DriftResource identifies a resource in a DriftReport. Kind is one of
+// Team, Project, Server, PrivateKey and Database, plus Environment for
+// manifest.Engine.DetectDrift.
+type DriftResource struct {
+	Kind string
+	UUID string
+	Name string
+}
+
+// FieldDrift is a field whose live value differs from the baseline.
+type FieldDrift struct {
+	Path     string
+	Baseline any
+	Live     any
+}
+
+// ResourceDrift lists the changed fields of a resource.
+type ResourceDrift struct {
+	DriftResource
+	Fields []FieldDrift
+}
+
+// DriftReport is the result of DetectDrift.
+type DriftReport struct {
+	Changed []ResourceDrift
+	// Added holds live resources missing from the baseline.
+	Added []DriftResource
+	// Removed holds baseline resources missing from the live instance.
+	Removed []DriftResource
+}
+
+// HasDrift reports whether the live instance differs from the baseline.
+func (r *DriftReport) HasDrift() bool {
+	return len(r.Changed) > 0 || len(r.Added) > 0 || len(r.Removed) > 0
+}
+
+// DetectDrift exports the live instance and compares it with a baseline
+// snapshot. Resources are matched by UUID, teams by ID. To use a manifest as
+// the baseline, see manifest.Engine.DetectDrift.
+func (c *Sdk) DetectDrift(ctx context.Context, baseline *Snapshot, opts DriftOptions) (*DriftReport, error) {
+	live, err := c.Export(ctx, ExportOptions{IncludeSecrets: opts.IncludeSecrets})
+	if err != nil {
+		return nil, err
+	}
+
+	ignore := opts.Ignore
+	if ignore == nil {
+		ignore = DefaultDriftIgnore
+	}
+
+	report := &DriftReport{}
+	compareResources(report, ignore, "Team", baseline.Teams, live.Teams,
+		func(t SnapshotTeam) (string, string) { return strconv.Itoa(t.Id), t.Name })
+	compareResources(report, ignore, "Project", baseline.Projects, live.Projects,
+		func(p Project) (string, string) { return p.UUID, p.Name })
+	compareResources(report, ignore, "Server", baseline.Servers, live.Servers,
+		func(s SnapshotServer) (string, string) { return s.UUID, s.Name })
+	compareResources(report, ignore, "PrivateKey", baseline.PrivateKeys, live.PrivateKeys,
+		func(k PrivateKey) (string, string) { return k.UUID, k.Name })
+	compareResources(report, ignore, "Database", baseline.Databases, live.Databases,
+		func(d Database) (string, string) { return d.UUID, d.Name })
+
+	return report, nil
+}
+
+func compareResources[T any](report *DriftReport, ignore []string, kind string, baseline, live []T, identify func(T) (string, string)) {
+	remaining := map[string]T{}
+	for _, resource := range live {
+		uuid, _ := identify(resource)
+		remaining[uuid] = resource
+	}
+
+	for _, old := range baseline {
+		uuid, name := identify(old)
+		current, ok := remaining[uuid]
+		if !ok {
+			report.Removed = append(report.Removed, DriftResource{Kind: kind, UUID: uuid, Name: name})
+			continue
+		}
+		delete(remaining, uuid)
+
+		var fields []FieldDrift
+		diffFields(&fields, ignore, kind, reflect.ValueOf(old), reflect.ValueOf(current))
+		if len(fields) > 0 {
+			report.Changed = append(report.Changed, ResourceDrift{
+				DriftResource: DriftResource{Kind: kind, UUID: uuid, Name: name},
+				Fields:        fields,
+			})
+		}
+	}
+
+	for _, resource := range live {
+		uuid, name := identify(resource)
+		if _, ok := remaining[uuid]; ok {
+			report.Added = append(report.Added, DriftResource{Kind: kind, UUID: uuid, Name: name})
+		}
+	}
+}
+
+var timestampType = reflect.TypeOf(client.Timestamp{})
+
+// diffFields walks two values of the same type and records the leaves that
+// differ. Embedded structs are flattened into their parent's path.
+func diffFields(out *[]FieldDrift, ignore []string, fieldPath string, old, current reflect.Value) {
+	if ignored(ignore, fieldPath) {
+		return
+	}
+
+	switch old.Kind() {
+	case reflect.Pointer:
+		if old.IsNil() || current.IsNil() {
+			if old.IsNil() != current.IsNil() {
+				*out = append(*out, FieldDrift{Path: fieldPath, Baseline: leaf(old), Live: leaf(current)})
+			}
+			return
+		}
+		diffFields(out, ignore, fieldPath, old.Elem(), current.Elem())
+		return
+
+	case reflect.Struct:
+		if old.Type() == timestampType {
+			if !old.Interface().(client.Timestamp).Equal(current.Interface().(client.Timestamp).Time) {
+				*out = append(*out, FieldDrift{Path: fieldPath, Baseline: leaf(old), Live: leaf(current)})
+			}
+			return
+		}
+		for i := 0; i < old.NumField(); i++ {
+			field := old.Type().Field(i)
+			if !field.IsExported() || field.Tag.Get("json") == "-" {
+				continue
+			}
+			child := fieldPath + "." + field.Name
+			if field.Anonymous {
+				child = fieldPath
+			}
+			diffFields(out, ignore, child, old.Field(i), current.Field(i))
+		}
+		return
+
+	case reflect.Slice:
+		if old.Len() != current.Len() {
+			*out = append(*out, FieldDrift{Path: fieldPath, Baseline: leaf(old), Live: leaf(current)})
+			return
+		}
+		for i := 0; i < old.Len(); i++ {
+			diffFields(out, ignore, fmt.Sprintf("%s[%d]", fieldPath, i), old.Index(i), current.Index(i))
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(old.Interface(), current.Interface()) {
+		*out = append(*out, FieldDrift{Path: fieldPath, Baseline: leaf(old), Live: leaf(current)})
+	}
+}
+
+func ignored(ignore []string, fieldPath string) bool {
+	name := fieldPath[strings.LastIndex(fieldPath, ".")+1:]
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+
+	for _, pattern := range ignore {
+		if ok, _ := path.Match(pattern, fieldPath); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// leaf returns the value to report for a field, dereferencing pointers.
+func leaf(v reflect.Value) any {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	return v.Interface()
+}
@@ -0,0 +1,249 @@
+package coolify_sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+	database "github.com/marconneves/coolify-sdk-go/database"
+	server "github.com/marconneves/coolify-sdk-go/server"
+)
+
+// EnsureProject creates the project named name unless one already exists, in
+// which case its description is updated to match dto. It returns the
+// project's UUID and whether it was created.
+func (c *Sdk) EnsureProject(ctx context.Context, name string, dto *CreateProjectDTO) (string, bool, error) {
+	if name == "" {
+		return "", false, errors.New("name is required")
+	}
+	if dto == nil {
+		dto = &CreateProjectDTO{}
+	}
+
+	projects, err := c.Project.ListWithContext(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, project := range *projects {
+		if project.Name != name {
+			continue
+		}
+		if dto.Description != nil && client.Deref(project.Description) != *dto.Description {
+			if err := c.Project.UpdateWithContext(ctx, project.UUID, &UpdateProjectDTO{Description: dto.Description}); err != nil {
+				return "", false, err
+			}
+		}
+		return project.UUID, false, nil
+	}
+
+	create := *dto
+	create.Name = &name
+	uuid, err := c.Project.CreateWithContext(ctx, &create)
+	if err != nil {
+		return "", false, err
+	}
+	return *uuid, true, nil
+}
+
+// EnsurePrivateKey creates the private key named name unless one already
+// exists, in which case its description and key material are updated to
+// match dto. It returns the key's UUID and whether it was created.
+func (c *Sdk) EnsurePrivateKey(ctx context.Context, name string, dto *CreatePrivateKeyDTO) (string, bool, error) {
+	if name == "" {
+		return "", false, errors.New("name is required")
+	}
+	if dto == nil || dto.PrivateKey == "" {
+		return "", false, errors.New("private key is required")
+	}
+
+	keys, err := c.PrivateKey.ListWithContext(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, key := range *keys {
+		if key.Name != name {
+			continue
+		}
+
+		update := UpdatePrivateKeyDTO{}
+		if dto.Description != nil && key.Description != *dto.Description {
+			update.Description = dto.Description
+		}
+		if strings.TrimSpace(key.PrivateKey) != strings.TrimSpace(dto.PrivateKey) {
+			update.PrivateKey = &dto.PrivateKey
+		}
+		if update != (UpdatePrivateKeyDTO{}) {
+			if err := c.PrivateKey.UpdateWithContext(ctx, key.UUID, &update); err != nil {
+				return "", false, err
+			}
+		}
+		return key.UUID, false, nil
+	}
+
+	create := *dto
+	create.Name = name
+	uuid, err := c.PrivateKey.CreateWithContext(ctx, &create)
+	if err != nil {
+		return "", false, err
+	}
+	return *uuid, true, nil
+}
+
+// EnsureServer creates the server unless one with the same IP and port
+// already exists, in which case its name, description, user, private key and
+// build server flag are updated to match dto. It returns the server's UUID
+// and whether it was created.
+func (c *Sdk) EnsureServer(ctx context.Context, dto *server.CreateServerDTO) (string, bool, error) {
+	if dto == nil || dto.IP == "" {
+		return "", false, errors.New("ip is required")
+	}
+
+	servers, err := c.Server.ListWithContext(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, current := range *servers {
+		if current.IP != dto.IP || serverPort(current.Port) != serverPort(dto.Port) {
+			continue
+		}
+
+		update := server.UpdateServerDTO{}
+		if dto.Name != "" && current.Name != dto.Name {
+			update.Name = dto.Name
+		}
+		if dto.Description != "" && client.Deref(current.Description) != dto.Description {
+			update.Description = dto.Description
+		}
+		if dto.User != "" && current.User != dto.User {
+			update.User = dto.User
+		}
+		if dto.IsBuildServer && (current.Settings == nil || !current.Settings.IsBuildServer) {
+			update.IsBuildServer = true
+		}
+		if dto.PrivateKeyUUID != "" {
+			key, err := c.PrivateKey.GetWithContext(ctx, dto.PrivateKeyUUID)
+			if err != nil {
+				return "", false, err
+			}
+			if key.ID != current.PrivateKeyID {
+				update.PrivateKeyUUID = dto.PrivateKeyUUID
+			}
+		}
+
+		if update != (server.UpdateServerDTO{}) {
+			if err := c.Server.UpdateWithContext(ctx, current.UUID, &update); err != nil {
+				return "", false, err
+			}
+		}
+		return current.UUID, false, nil
+	}
+
+	uuid, err := c.Server.CreateWithContext(ctx, dto)
+	if err != nil {
+		return "", false, err
+	}
+	return *uuid, true, nil
+}
+
+// EnsureDatabase creates the database unless one with the same name already
+// exists in the DTO's project and environment, in which case the fields set
+// in dto are updated where they differ. An existing database of another
+// type is an error. It returns the database's UUID and whether it was
+// created.
+func (c *Sdk) EnsureDatabase(ctx context.Context, dto database.CreateDatabaseDTO) (string, bool, error) {
+	target := dto.Target()
+	if target.ProjectUUID == "" || target.Environment == "" || target.Name == "" {
+		return "", false, errors.New("project, environment and name are required")
+	}
+
+	filter := database.Filter{ProjectUUID: target.ProjectUUID, Environment: target.Environment}
+	for current, err := range c.Database.All(ctx, filter) {
+		if err != nil {
+			return "", false, err
+		}
+		if current.Name != target.Name {
+			continue
+		}
+		if current.DatabaseType != target.Type {
+			return "", false, fmt.Errorf("database %q is a %s, not a %s", target.Name, current.DatabaseType, target.Type)
+		}
+
+		update := databaseChanges(&current, dto.UpdateDTO())
+		if update != (database.UpdateDatabaseDTO{}) {
+			if err := c.Database.Update(ctx, current.UUID, &update); err != nil {
+				return "", false, err
+			}
+		}
+		return current.UUID, false, nil
+	}
+
+	uuid, err := c.Database.Create(ctx, dto)
+	if err != nil {
+		return "", false, err
+	}
+	return *uuid, true, nil
+}
+
+// databaseChanges keeps the fields of desired that differ from current.
+func databaseChanges(current *database.Database, desired *database.UpdateDatabaseDTO) database.UpdateDatabaseDTO {
+	return database.UpdateDatabaseDTO{
+		Description: changedPtr(desired.Description, current.Description),
+		PublicPort:  changed(desired.PublicPort, current.PublicPort),
+		Image:       changed(desired.Image, current.Image),
+		IsPublic:    changed(desired.IsPublic, current.IsPublic),
+
+		LimitsCPUShares:         changed(desired.LimitsCPUShares, current.LimitsCPUShares),
+		LimitsCpus:              changed(desired.LimitsCpus, current.LimitsCpus),
+		LimitsCpuset:            changedPtr(desired.LimitsCpuset, current.LimitsCpuset),
+		LimitsMemory:            changed(desired.LimitsMemory, current.LimitsMemory),
+		LimitsMemoryReservation: changed(desired.LimitsMemoryReservation, current.LimitsMemoryReservation),
+		LimitsMemorySwap:        changed(desired.LimitsMemorySwap, current.LimitsMemorySwap),
+		LimitsMemorySwappiness:  changed(desired.LimitsMemorySwappiness, current.LimitsMemorySwappiness),
+
+		MariadbConf:         changedPtr(desired.MariadbConf, current.MariadbConf),
+		MariadbDatabase:     changedPtr(desired.MariadbDatabase, current.MariadbDatabase),
+		MariadbPassword:     changedPtr(desired.MariadbPassword, current.MariadbPassword),
+		MariadbRootPassword: changedPtr(desired.MariadbRootPassword, current.MariadbRootPassword),
+		MariadbUser:         changedPtr(desired.MariadbUser, current.MariadbUser),
+
+		MysqlConf:         changedPtr(desired.MysqlConf, current.MysqlConf),
+		MysqlDatabase:     changedPtr(desired.MysqlDatabase, current.MysqlDatabase),
+		MysqlPassword:     changedPtr(desired.MysqlPassword, current.MysqlPassword),
+		MysqlRootPassword: changedPtr(desired.MysqlRootPassword, current.MysqlRootPassword),
+		MysqlUser:         changedPtr(desired.MysqlUser, current.MysqlUser),
+
+		PostgresConf:           changedPtr(desired.PostgresConf, current.PostgresConf),
+		PostgresDB:             changed(desired.PostgresDB, current.PostgresDB),
+		PostgresHostAuthMethod: changedPtr(desired.PostgresHostAuthMethod, current.PostgresHostAuthMethod),
+		PostgresInitdbArgs:     changedPtr(desired.PostgresInitdbArgs, current.PostgresInitdbArgs),
+		PostgresPassword:       changed(desired.PostgresPassword, current.PostgresPassword),
+		PostgresUser:           changed(desired.PostgresUser, current.PostgresUser),
+
+		RedisConf:     changedPtr(desired.RedisConf, current.RedisConf),
+		RedisPassword: changedPtr(desired.RedisPassword, current.RedisPassword),
+	}
+}
+
+// changed returns desired when it is set and differs from current.
+func changed[T comparable](desired *T, current T) *T {
+	if desired == nil || *desired == current {
+		return nil
+	}
+	return desired
+}
+
+func changedPtr(desired *string, current *string) *string {
+	return changed(desired, client.Deref(current))
+}
+
+func serverPort(port int) int {
+	if port == 0 {
+		return 22
+	}
+	return port
+}
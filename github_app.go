@@ -0,0 +1,260 @@
+package coolify_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+)
+
+// GitHubAppInstance manages the GitHub App sources used to deploy private
+// repositories.
+type GitHubAppInstance struct {
+	client *client.Client
+}
+
+// GitHubApp represents a GitHub App source Coolify deploys private
+// repositories with.
+type GitHubApp struct {
+	ID             int       `json:"id"`
+	UUID           string    `json:"uuid"`
+	Name           string    `json:"name"`
+	Organization   *string   `json:"organization"`
+	APIURL         string    `json:"api_url"`
+	HTMLURL        string    `json:"html_url"`
+	CustomUser     string    `json:"custom_user"`
+	CustomPort     int       `json:"custom_port"`
+	AppID          int       `json:"app_id"`
+	InstallationID int       `json:"installation_id"`
+	ClientID       string    `json:"client_id"`
+	ClientSecret   string    `json:"client_secret"`
+	WebhookSecret  string    `json:"webhook_secret"`
+	PrivateKeyID   int       `json:"private_key_id"`
+	IsSystemWide   bool      `json:"is_system_wide"`
+	IsPublic       bool      `json:"is_public"`
+	TeamID         int       `json:"team_id"`
+	CreatedAt      Timestamp `json:"created_at"`
+	UpdatedAt      Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GitHubRepository is a repository the app installation can access.
+type GitHubRepository struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Private       bool   `json:"private"`
+	DefaultBranch string `json:"default_branch"`
+	HTMLURL       string `json:"html_url"`
+	Owner         struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// GitHubBranch is a branch of a repository.
+type GitHubBranch struct {
+	Name      string `json:"name"`
+	Protected bool   `json:"protected"`
+	Commit    struct {
+		SHA string `json:"sha"`
+	} `json:"commit"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// CreateGitHubAppDTO registers an existing GitHub App. APIURL and HTMLURL
+// default to github.com.
+type CreateGitHubAppDTO struct {
+	Name           string  `json:"name"`
+	Organization   *string `json:"organization,omitempty"`
+	APIURL         string  `json:"api_url"`
+	HTMLURL        string  `json:"html_url"`
+	CustomUser     *string `json:"custom_user,omitempty"`
+	CustomPort     *int    `json:"custom_port,omitempty"`
+	AppID          int     `json:"app_id"`
+	InstallationID int     `json:"installation_id"`
+	ClientID       string  `json:"client_id"`
+	ClientSecret   string  `json:"client_secret"`
+	WebhookSecret  string  `json:"webhook_secret"`
+	PrivateKeyUUID string  `json:"private_key_uuid"`
+	IsSystemWide   *bool   `json:"is_system_wide,omitempty"`
+}
+
+// UpdateGitHubAppDTO represents the data required to update a GitHub App
+// source. Nil fields are left unchanged.
+type UpdateGitHubAppDTO struct {
+	Name           *string `json:"name,omitempty"`
+	Organization   *string `json:"organization,omitempty"`
+	APIURL         *string `json:"api_url,omitempty"`
+	HTMLURL        *string `json:"html_url,omitempty"`
+	CustomUser     *string `json:"custom_user,omitempty"`
+	CustomPort     *int    `json:"custom_port,omitempty"`
+	AppID          *int    `json:"app_id,omitempty"`
+	InstallationID *int    `json:"installation_id,omitempty"`
+	ClientID       *string `json:"client_id,omitempty"`
+	ClientSecret   *string `json:"client_secret,omitempty"`
+	WebhookSecret  *string `json:"webhook_secret,omitempty"`
+	PrivateKeyUUID *string `json:"private_key_uuid,omitempty"`
+	IsSystemWide   *bool   `json:"is_system_wide,omitempty"`
+}
+
+// List retrieves all GitHub App sources.
+func (g *GitHubAppInstance) List(ctx context.Context) (*[]GitHubApp, error) {
+	body, err := g.client.HttpRequestWithContext(ctx, "github-apps", "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list GitHub Apps: %w", err)
+	}
+
+	res, err := client.DecodeResponse(body, &[]GitHubApp{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode GitHub Apps list: %w", err)
+	}
+
+	return res, nil
+}
+
+// Get retrieves a GitHub App source by ID.
+func (g *GitHubAppInstance) Get(ctx context.Context, id int) (*GitHubApp, error) {
+	body, err := g.client.HttpRequestWithContext(ctx, fmt.Sprintf("github-apps/%v", id), "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GitHub App %d: %w", id, err)
+	}
+
+	res, err := client.DecodeResponse(body, &GitHubApp{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode GitHub App %d: %w", id, err)
+	}
+
+	return res, nil
+}
+
+// Create registers a GitHub App source whose private key is already stored
+// in Coolify.
+func (g *GitHubAppInstance) Create(ctx context.Context, app *CreateGitHubAppDTO) (*GitHubApp, error) {
+	if app.PrivateKeyUUID == "" {
+		return nil, errors.New("private key uuid is required")
+	}
+
+	data := *app
+	if data.APIURL == "" {
+		data.APIURL = "https://api.github.com"
+	}
+	if data.HTMLURL == "" {
+		data.HTMLURL = "https://github.com"
+	}
+
+	buf, err := client.EncodeRequest(&data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	body, err := g.client.HttpRequestWithContext(ctx, "github-apps", "POST", *buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitHub App: %w", err)
+	}
+
+	res, err := client.DecodeResponse(body, &GitHubApp{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return res, nil
+}
+
+// CreateWithKey stores the app's private key with PrivateKeyInstance and
+// registers the app with it. The key is removed again if the app cannot be
+// created.
+func (g *GitHubAppInstance) CreateWithKey(ctx context.Context, app *CreateGitHubAppDTO, key *CreatePrivateKeyDTO) (*GitHubApp, error) {
+	keys := &PrivateKeyInstance{client: g.client}
+
+	keyUUID, err := keys.CreateWithContext(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the app private key: %w", err)
+	}
+
+	data := *app
+	data.PrivateKeyUUID = *keyUUID
+
+	created, err := g.Create(ctx, &data)
+	if err != nil {
+		if cleanupErr := keys.DeleteWithContext(context.WithoutCancel(ctx), *keyUUID); cleanupErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to delete private key %s: %w", *keyUUID, cleanupErr))
+		}
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// Update updates a GitHub App source.
+func (g *GitHubAppInstance) Update(ctx context.Context, id int, app *UpdateGitHubAppDTO) error {
+	buf, err := client.EncodeRequest(app)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	body, err := g.client.HttpRequestWithContext(ctx, fmt.Sprintf("github-apps/%v", id), "PATCH", *buf)
+	if err != nil {
+		return fmt.Errorf("failed to update GitHub App %d: %w", id, err)
+	}
+	body.Close()
+
+	return nil
+}
+
+// Delete removes a GitHub App source. Coolify refuses to delete apps still
+// used by applications.
+func (g *GitHubAppInstance) Delete(ctx context.Context, id int) error {
+	body, err := g.client.HttpRequestWithContext(ctx, fmt.Sprintf("github-apps/%v", id), "DELETE")
+	if err != nil {
+		return fmt.Errorf("failed to delete GitHub App %d: %w", id, err)
+	}
+	body.Close()
+
+	return nil
+}
+
+// Repositories lists the repositories the app installation can access.
+func (g *GitHubAppInstance) Repositories(ctx context.Context, id int) (*[]GitHubRepository, error) {
+	body, err := g.client.HttpRequestWithContext(ctx, fmt.Sprintf("github-apps/%v/repositories", id), "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories of GitHub App %d: %w", id, err)
+	}
+
+	response, err := client.DecodeResponse(body, &struct {
+		Repositories []GitHubRepository `json:"repositories"`
+	}{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode repositories of GitHub App %d: %w", id, err)
+	}
+
+	return &response.Repositories, nil
+}
+
+// Branches lists the branches of owner/repo as seen by the app.
+func (g *GitHubAppInstance) Branches(ctx context.Context, id int, owner, repo string) (*[]GitHubBranch, error) {
+	if owner == "" || repo == "" {
+		return nil, errors.New("owner and repo are required")
+	}
+
+	path := fmt.Sprintf("github-apps/%v/repositories/%s/%s/branches", id, url.PathEscape(owner), url.PathEscape(repo))
+	body, err := g.client.HttpRequestWithContext(ctx, path, "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches of %s/%s: %w", owner, repo, err)
+	}
+
+	response, err := client.DecodeResponse(body, &struct {
+		Branches []GitHubBranch `json:"branches"`
+	}{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode branches of %s/%s: %w", owner, repo, err)
+	}
+
+	return &response.Branches, nil
+}
@@ -1,3 +1,14 @@
 module github.com/marconneves/coolify-sdk-go
 
 go 1.23.2
+
+require (
+	go.uber.org/mock v0.6.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/kr/pretty v0.3.1 // indirect
+	github.com/rogpeppe/go-internal v1.13.1 // indirect
+	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
+)
@@ -0,0 +1,107 @@
+package coolify_sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+)
+
+// Health describes whether a Coolify instance can serve the SDK.
+type Health struct {
+	// Reachable is set when the healthcheck endpoint answered.
+	Reachable bool
+	// Latency is the round-trip time of the healthcheck request.
+	Latency time.Duration
+	// Version is the Coolify version, known once the API can be used.
+	Version *Version
+	// APIEnabled is false when Coolify rejects the token because the API
+	// is disabled in its settings.
+	APIEnabled bool
+	// Authenticated is set when the token is accepted.
+	Authenticated bool
+	// Team is the team the token is scoped to.
+	Team *Team
+}
+
+// Ready reports whether the instance is reachable and the token can use the
+// API.
+func (h *Health) Ready() bool {
+	return h.Reachable && h.Authenticated && h.APIEnabled
+}
+
+// Health checks the instance, the API and the token. The returned error is
+// only set when the checks could not be completed, for example because the
+// instance is unreachable; a rejected token or a disabled API is reported in
+// the Health. Its requests bypass the client's cache and retries.
+func (c *Sdk) Health(ctx context.Context) (*Health, error) {
+	health := &Health{}
+
+	start := time.Now()
+	body, err := c.Client.Probe(ctx, "healthcheck")
+	health.Latency = time.Since(start)
+	if err != nil {
+		return health, err
+	}
+	body.Close()
+	health.Reachable = true
+
+	team, err := c.currentTeam(ctx)
+	switch client.StatusCode(err) {
+	case 0:
+		if err != nil {
+			return health, err
+		}
+	case http.StatusUnauthorized, http.StatusBadRequest:
+		return health, nil
+	case http.StatusForbidden:
+		health.Authenticated = true
+		return health, nil
+	default:
+		return health, err
+	}
+	health.Authenticated = true
+	health.APIEnabled = true
+	health.Team = team
+
+	version, err := c.currentVersion(ctx)
+	if err != nil {
+		return health, err
+	}
+	health.Version = version
+
+	return health, nil
+}
+
+// currentTeam fetches the token's team without going through the cache.
+func (c *Sdk) currentTeam(ctx context.Context) (*Team, error) {
+	body, err := c.Client.Probe(ctx, "teams/current")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &Team{})
+}
+
+// currentVersion fetches the instance's version without going through the
+// cache, so that an upgrade shows up right away.
+func (c *Sdk) currentVersion(ctx context.Context) (*Version, error) {
+	body, err := c.Client.Probe(ctx, "version")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := client.ParseVersion(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
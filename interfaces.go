@@ -0,0 +1,147 @@
+package coolify_sdk
+
+import (
+	"context"
+	"iter"
+
+	database "github.com/marconneves/coolify-sdk-go/database"
+	destination "github.com/marconneves/coolify-sdk-go/destination"
+	server "github.com/marconneves/coolify-sdk-go/server"
+	storage "github.com/marconneves/coolify-sdk-go/storage"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=mocks/mocks.go -package=mocks
+
+// ServerAPI is implemented by server.ServerInstance.
+type ServerAPI interface {
+	List() (*[]server.Server, error)
+	ListWithContext(ctx context.Context) (*[]server.Server, error)
+	All(ctx context.Context) iter.Seq2[server.Server, error]
+	Get(uuid string) (*server.Server, error)
+	GetWithContext(ctx context.Context, uuid string) (*server.Server, error)
+	Create(server *server.CreateServerDTO) (*string, error)
+	CreateWithContext(ctx context.Context, server *server.CreateServerDTO) (*string, error)
+	Update(uuid string, server *server.UpdateServerDTO) error
+	UpdateWithContext(ctx context.Context, uuid string, server *server.UpdateServerDTO) error
+	Delete(uuid string) error
+	DeleteWithContext(ctx context.Context, uuid string) error
+	Resources(uuid string) (*[]server.Resource, error)
+	ResourcesWithContext(ctx context.Context, uuid string) (*[]server.Resource, error)
+	Domains(uuid string) (*[]server.Domain, error)
+	DomainsWithContext(ctx context.Context, uuid string) (*[]server.Domain, error)
+	Validate(uuid string) error
+	ValidateWithContext(ctx context.Context, uuid string) error
+}
+
+// DatabaseAPI is implemented by database.DatabaseInstance.
+type DatabaseAPI interface {
+	List(ctx context.Context) (*[]database.Database, error)
+	All(ctx context.Context, filter database.Filter) iter.Seq2[database.Database, error]
+	Get(ctx context.Context, uuid string) (*database.Database, error)
+	Start(ctx context.Context, uuid string) error
+	Stop(ctx context.Context, uuid string) error
+	Restart(ctx context.Context, uuid string) error
+	WaitForStatus(ctx context.Context, uuid string, desired database.Status, opts database.WaitOptions) error
+	StartAndWait(ctx context.Context, uuid string, opts database.WaitOptions) error
+	StopAndWait(ctx context.Context, uuid string, opts database.WaitOptions) error
+	RestartAndWait(ctx context.Context, uuid string, opts database.WaitOptions) error
+	Delete(ctx context.Context, uuid string) error
+	Update(ctx context.Context, uuid string, data *database.UpdateDatabaseDTO) error
+	CreatePostgreSQL(ctx context.Context, data *database.CreateDatabasePostgresDTO) (*string, error)
+	CreateMySQL(ctx context.Context, data *database.CreateDatabaseMySQLDTO) (*string, error)
+	CreateMariaDB(ctx context.Context, data *database.CreateDatabaseMariaDBDTO) (*string, error)
+	CreateRedis(ctx context.Context, data *database.CreateDatabaseRedisDTO) (*string, error)
+	Create(ctx context.Context, data database.CreateDatabaseDTO) (*string, error)
+	Storages(uuid string) storage.StorageAPI
+}
+
+// DestinationAPI is implemented by destination.DestinationInstance.
+type DestinationAPI interface {
+	List(ctx context.Context, serverUUID string) (*[]destination.Destination, error)
+	Get(ctx context.Context, uuid string) (*destination.Destination, error)
+	Default(ctx context.Context, serverUUID string) (*destination.Destination, error)
+	Create(ctx context.Context, data *destination.CreateDestinationDTO) (*string, error)
+	Delete(ctx context.Context, uuid string) error
+}
+
+// StorageAPI is implemented by storage.StorageInstance.
+type StorageAPI interface {
+	storage.StorageAPI
+}
+
+// S3StorageAPI is implemented by storage.S3StorageInstance.
+type S3StorageAPI interface {
+	List(ctx context.Context) (*[]storage.S3Storage, error)
+	Get(ctx context.Context, uuid string) (*storage.S3Storage, error)
+	Create(ctx context.Context, data *storage.CreateS3StorageDTO) (*string, error)
+	Update(ctx context.Context, uuid string, data *storage.UpdateS3StorageDTO) error
+	Delete(ctx context.Context, uuid string) error
+	Test(ctx context.Context, uuid string) error
+}
+
+// ProjectAPI is implemented by ProjectInstance.
+type ProjectAPI interface {
+	List() (*[]Project, error)
+	ListWithContext(ctx context.Context) (*[]Project, error)
+	All(ctx context.Context) iter.Seq2[Project, error]
+	Get(uuid string) (*Project, error)
+	GetWithContext(ctx context.Context, uuid string) (*Project, error)
+	Create(project *CreateProjectDTO) (*string, error)
+	CreateWithContext(ctx context.Context, project *CreateProjectDTO) (*string, error)
+	Update(uuid string, project *UpdateProjectDTO) error
+	UpdateWithContext(ctx context.Context, uuid string, project *UpdateProjectDTO) error
+	Delete(uuid string) error
+	DeleteWithContext(ctx context.Context, uuid string) error
+	Environment(uuid string, environment string) (*EnvironmentData, error)
+	EnvironmentWithContext(ctx context.Context, uuid string, environment string) (*EnvironmentData, error)
+}
+
+// PrivateKeyAPI is implemented by PrivateKeyInstance.
+type PrivateKeyAPI interface {
+	List() (*[]PrivateKey, error)
+	ListWithContext(ctx context.Context) (*[]PrivateKey, error)
+	All(ctx context.Context) iter.Seq2[PrivateKey, error]
+	Get(uuid string) (*PrivateKey, error)
+	GetWithContext(ctx context.Context, uuid string) (*PrivateKey, error)
+	Create(privateKey *CreatePrivateKeyDTO) (*string, error)
+	CreateWithContext(ctx context.Context, privateKey *CreatePrivateKeyDTO) (*string, error)
+	Update(uuid string, privateKey *UpdatePrivateKeyDTO) error
+	UpdateWithContext(ctx context.Context, uuid string, privateKey *UpdatePrivateKeyDTO) error
+	Delete(uuid string) error
+	DeleteWithContext(ctx context.Context, uuid string) error
+}
+
+// GitHubAppAPI is implemented by GitHubAppInstance.
+type GitHubAppAPI interface {
+	List(ctx context.Context) (*[]GitHubApp, error)
+	Get(ctx context.Context, id int) (*GitHubApp, error)
+	Create(ctx context.Context, app *CreateGitHubAppDTO) (*GitHubApp, error)
+	CreateWithKey(ctx context.Context, app *CreateGitHubAppDTO, key *CreatePrivateKeyDTO) (*GitHubApp, error)
+	Update(ctx context.Context, id int, app *UpdateGitHubAppDTO) error
+	Delete(ctx context.Context, id int) error
+	Repositories(ctx context.Context, id int) (*[]GitHubRepository, error)
+	Branches(ctx context.Context, id int, owner, repo string) (*[]GitHubBranch, error)
+}
+
+// TeamAPI is implemented by TeamInstance.
+type TeamAPI interface {
+	List() (*[]Team, error)
+	ListWithContext(ctx context.Context) (*[]Team, error)
+	Get(id int) (*Team, error)
+	GetWithContext(ctx context.Context, id int) (*Team, error)
+	Current(ctx context.Context) (*Team, error)
+	Members(id int) (*[]Member, error)
+	MembersWithContext(ctx context.Context, id int) (*[]Member, error)
+}
+
+var (
+	_ ServerAPI      = (*server.ServerInstance)(nil)
+	_ DatabaseAPI    = (*database.DatabaseInstance)(nil)
+	_ DestinationAPI = (*destination.DestinationInstance)(nil)
+	_ StorageAPI     = (*storage.StorageInstance)(nil)
+	_ S3StorageAPI   = (*storage.S3StorageInstance)(nil)
+	_ ProjectAPI     = (*ProjectInstance)(nil)
+	_ PrivateKeyAPI  = (*PrivateKeyInstance)(nil)
+	_ GitHubAppAPI   = (*GitHubAppInstance)(nil)
+	_ TeamAPI        = (*TeamInstance)(nil)
+)
@@ -0,0 +1,260 @@
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/database"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+// ErrUnsupported is returned by Apply for changes the Coolify API cannot
+// make, such as creating environments.
+var ErrUnsupported = errors.New("not supported by the Coolify API")
+
+// Apply runs the plan's changes in order. It checks the whole plan before
+// making any request and stops at the first failed change; changes already
+// made are not rolled back.
+func (e *Engine) Apply(ctx context.Context, plan *Plan) error {
+	for _, change := range plan.Changes {
+		if change.Kind == KindEnvironment && change.Action == ActionCreate {
+			return fmt.Errorf("environment %q: creating environments is %w", change.Name, ErrUnsupported)
+		}
+	}
+
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+		if change.Action == ActionNoop {
+			continue
+		}
+
+		if err := e.apply(ctx, plan, change); err != nil {
+			return fmt.Errorf("failed to %s %s %q: %w", change.Action, change.Kind, change.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (e *Engine) apply(ctx context.Context, plan *Plan, change *Change) error {
+	if change.Action == ActionDelete {
+		return e.delete(ctx, change)
+	}
+
+	switch change.Kind {
+	case KindPrivateKey:
+		return e.applyPrivateKey(ctx, plan, change)
+	case KindServer:
+		return e.applyServer(ctx, plan, change)
+	case KindProject:
+		return e.applyProject(ctx, plan, change)
+	case KindDatabase:
+		return e.applyDatabase(ctx, plan, change)
+	}
+
+	return fmt.Errorf("unknown resource kind %q", change.Kind)
+}
+
+func (e *Engine) delete(ctx context.Context, change *Change) error {
+	switch change.Kind {
+	case KindPrivateKey:
+		return e.PrivateKeys.DeleteWithContext(ctx, change.UUID)
+	case KindServer:
+		return e.Servers.DeleteWithContext(ctx, change.UUID)
+	case KindProject:
+		return e.Projects.DeleteWithContext(ctx, change.UUID)
+	case KindDatabase:
+		return e.Databases.Delete(ctx, change.UUID)
+	}
+
+	return fmt.Errorf("cannot delete %s", change.Kind)
+}
+
+func (e *Engine) applyPrivateKey(ctx context.Context, plan *Plan, change *Change) error {
+	desired := change.privateKey
+
+	if change.Action == ActionCreate {
+		uuid, err := e.PrivateKeys.CreateWithContext(ctx, &coolify_sdk.CreatePrivateKeyDTO{
+			Name:        desired.Name,
+			Description: &desired.Description,
+			PrivateKey:  desired.PrivateKey,
+		})
+		if err != nil {
+			return err
+		}
+		change.UUID = *uuid
+		plan.privateKeys[desired.Name] = *uuid
+		return nil
+	}
+
+	return e.PrivateKeys.UpdateWithContext(ctx, change.UUID, &coolify_sdk.UpdatePrivateKeyDTO{
+		Description: &desired.Description,
+		PrivateKey:  &desired.PrivateKey,
+	})
+}
+
+func (e *Engine) applyServer(ctx context.Context, plan *Plan, change *Change) error {
+	desired := change.server
+
+	keyUUID, ok := plan.privateKeys[desired.PrivateKey]
+	if !ok {
+		return fmt.Errorf("unknown private key %q", desired.PrivateKey)
+	}
+
+	if change.Action == ActionCreate {
+		uuid, err := e.Servers.CreateWithContext(ctx, &server.CreateServerDTO{
+			Name:           desired.Name,
+			Description:    desired.Description,
+			IP:             desired.IP,
+			Port:           desired.Port,
+			User:           desired.User,
+			PrivateKeyUUID: keyUUID,
+			IsBuildServer:  desired.IsBuildServer,
+		})
+		if err != nil {
+			return err
+		}
+		change.UUID = *uuid
+		plan.servers[desired.Name] = *uuid
+		return nil
+	}
+
+	// UpdateServerDTO omits false booleans, so a build server cannot be
+	// turned back into a regular one through Apply.
+	return e.Servers.UpdateWithContext(ctx, change.UUID, &server.UpdateServerDTO{
+		Description:    desired.Description,
+		IP:             desired.IP,
+		Port:           desired.Port,
+		User:           desired.User,
+		PrivateKeyUUID: keyUUID,
+		IsBuildServer:  desired.IsBuildServer,
+	})
+}
+
+func (e *Engine) applyProject(ctx context.Context, plan *Plan, change *Change) error {
+	desired := change.project
+
+	if change.Action == ActionCreate {
+		uuid, err := e.Projects.CreateWithContext(ctx, &coolify_sdk.CreateProjectDTO{
+			Name:        &desired.Name,
+			Description: &desired.Description,
+		})
+		if err != nil {
+			return err
+		}
+		change.UUID = *uuid
+		plan.projects[desired.Name] = *uuid
+		return nil
+	}
+
+	return e.Projects.UpdateWithContext(ctx, change.UUID, &coolify_sdk.UpdateProjectDTO{
+		Description: &desired.Description,
+	})
+}
+
+func (e *Engine) applyDatabase(ctx context.Context, plan *Plan, change *Change) error {
+	desired := change.database
+
+	if change.Action == ActionUpdate {
+		update := &database.UpdateDatabaseDTO{
+			Description: &desired.Description,
+			IsPublic:    &desired.IsPublic,
+		}
+		if desired.Image != "" {
+			update.Image = &desired.Image
+		}
+		if desired.PublicPort != 0 {
+			update.PublicPort = &desired.PublicPort
+		}
+		if desired.LimitsMemory != "" {
+			update.LimitsMemory = &desired.LimitsMemory
+		}
+		if desired.LimitsCpus != "" {
+			update.LimitsCpus = &desired.LimitsCpus
+		}
+		return e.Databases.Update(ctx, change.UUID, update)
+	}
+
+	serverUUID, ok := plan.servers[desired.Server]
+	if !ok {
+		return fmt.Errorf("unknown server %q", desired.Server)
+	}
+	projectUUID, ok := plan.projects[desired.Project]
+	if !ok {
+		return fmt.Errorf("unknown project %q", desired.Project)
+	}
+
+	uuid, err := e.createDatabase(ctx, desired, serverUUID, projectUUID)
+	if err != nil {
+		return err
+	}
+	change.UUID = *uuid
+	return nil
+}
+
+func (e *Engine) createDatabase(ctx context.Context, desired *Database, serverUUID, projectUUID string) (*string, error) {
+	image := client.Optional(desired.Image)
+	publicPort := client.Optional(desired.PublicPort)
+	limitsMemory := client.Optional(desired.LimitsMemory)
+	limitsCpus := client.Optional(desired.LimitsCpus)
+
+	switch desired.Type {
+	case DatabasePostgreSQL:
+		return e.Databases.CreatePostgreSQL(ctx, &database.CreateDatabasePostgresDTO{
+			ServerUUID:   serverUUID,
+			ProjectUUID:  projectUUID,
+			Environment:  desired.Environment,
+			Name:         &desired.Name,
+			Description:  &desired.Description,
+			Image:        image,
+			IsPublic:     &desired.IsPublic,
+			PublicPort:   publicPort,
+			LimitsMemory: limitsMemory,
+			LimitsCPUs:   limitsCpus,
+		})
+	case DatabaseMySQL:
+		return e.Databases.CreateMySQL(ctx, &database.CreateDatabaseMySQLDTO{
+			ServerUUID:      serverUUID,
+			ProjectUUID:     projectUUID,
+			EnvironmentName: desired.Environment,
+			Name:            &desired.Name,
+			Description:     &desired.Description,
+			Image:           image,
+			IsPublic:        &desired.IsPublic,
+			PublicPort:      publicPort,
+			LimitsMemory:    limitsMemory,
+			LimitsCPUs:      limitsCpus,
+		})
+	case DatabaseMariaDB:
+		return e.Databases.CreateMariaDB(ctx, &database.CreateDatabaseMariaDBDTO{
+			ServerUUID:      serverUUID,
+			ProjectUUID:     projectUUID,
+			EnvironmentName: desired.Environment,
+			Name:            &desired.Name,
+			Description:     &desired.Description,
+			Image:           image,
+			IsPublic:        &desired.IsPublic,
+			PublicPort:      publicPort,
+			LimitsMemory:    limitsMemory,
+			LimitsCPUs:      limitsCpus,
+		})
+	case DatabaseRedis:
+		return e.Databases.CreateRedis(ctx, &database.CreateDatabaseRedisDTO{
+			ServerUUID:      serverUUID,
+			ProjectUUID:     projectUUID,
+			EnvironmentName: desired.Environment,
+			Name:            &desired.Name,
+			Description:     &desired.Description,
+			Image:           image,
+			IsPublic:        &desired.IsPublic,
+			PublicPort:      publicPort,
+			LimitsMemory:    limitsMemory,
+			LimitsCPUs:      limitsCpus,
+		})
+	}
+
+	return nil, fmt.Errorf("unsupported database type %q", desired.Type)
+}
@@ -0,0 +1,53 @@
+package manifest
+
+import (
+	"context"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+)
+
+// driftKinds maps manifest kinds to the kinds of coolify_sdk.DriftResource.
+var driftKinds = map[Kind]string{
+	KindPrivateKey:  "PrivateKey",
+	KindServer:      "Server",
+	KindProject:     "Project",
+	KindEnvironment: "Environment",
+	KindDatabase:    "Database",
+}
+
+// DetectDrift compares the live instance with the manifest used as a
+// baseline. Only the fields the manifest declares are compared, so the
+// report matches the updates Plan would make. Declared resources missing
+// from the instance are reported as removed and the live resources a pruning
+// plan would delete as added. Resources are identified by their manifest
+// names, with the UUID set when the resource exists.
+func (e *Engine) DetectDrift(ctx context.Context, m *Manifest) (*coolify_sdk.DriftReport, error) {
+	plan, err := e.Plan(ctx, m, PlanOptions{Prune: true})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &coolify_sdk.DriftReport{}
+	for _, change := range plan.Changes {
+		resource := coolify_sdk.DriftResource{Kind: driftKinds[change.Kind], UUID: change.UUID, Name: change.Name}
+
+		switch change.Action {
+		case ActionCreate:
+			report.Removed = append(report.Removed, resource)
+		case ActionDelete:
+			report.Added = append(report.Added, resource)
+		case ActionUpdate:
+			drift := coolify_sdk.ResourceDrift{DriftResource: resource}
+			for _, diff := range change.Diffs {
+				drift.Fields = append(drift.Fields, coolify_sdk.FieldDrift{
+					Path:     resource.Kind + "." + diff.Field,
+					Baseline: diff.New,
+					Live:     diff.Old,
+				})
+			}
+			report.Changed = append(report.Changed, drift)
+		}
+	}
+
+	return report, nil
+}
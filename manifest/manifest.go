@@ -0,0 +1,196 @@
+// Package manifest reconciles a Coolify instance with a declarative
+// description of its private keys, servers, projects and databases.
+//
+//	m, err := manifest.Load("coolify.yaml")
+//	if err != nil {
+//		return err
+//	}
+//
+//	engine := manifest.NewEngine(sdk)
+//	plan, err := engine.Plan(ctx, m, manifest.PlanOptions{})
+//	if err != nil {
+//		return err
+//	}
+//	fmt.Print(plan)
+//
+//	return engine.Apply(ctx, plan)
+//
+// Resources are identified by name; databases by project, environment and
+// name. Servers reference private keys and databases reference servers and
+// projects by name.
+package manifest
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is the desired state of a Coolify instance. It is read from YAML
+// or JSON.
+type Manifest struct {
+	PrivateKeys []PrivateKey `yaml:"private_keys,omitempty" json:"private_keys,omitempty"`
+	Servers     []Server     `yaml:"servers,omitempty" json:"servers,omitempty"`
+	Projects    []Project    `yaml:"projects,omitempty" json:"projects,omitempty"`
+	Databases   []Database   `yaml:"databases,omitempty" json:"databases,omitempty"`
+}
+
+// PrivateKey is a desired private key.
+type PrivateKey struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// PrivateKey is the PEM encoded key. PrivateKeyFile may be used instead.
+	PrivateKey     string `yaml:"private_key,omitempty" json:"private_key,omitempty"`
+	PrivateKeyFile string `yaml:"private_key_file,omitempty" json:"private_key_file,omitempty"`
+}
+
+// Server is a desired server.
+type Server struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	IP          string `yaml:"ip" json:"ip"`
+	Port        int    `yaml:"port,omitempty" json:"port,omitempty"`
+	User        string `yaml:"user,omitempty" json:"user,omitempty"`
+	// PrivateKey is the name of a private key of the manifest or of the
+	// instance.
+	PrivateKey    string `yaml:"private_key" json:"private_key"`
+	IsBuildServer bool   `yaml:"is_build_server,omitempty" json:"is_build_server,omitempty"`
+}
+
+// Project is a desired project.
+type Project struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// Environments lists environment names. Defaults to "production".
+	Environments []string `yaml:"environments,omitempty" json:"environments,omitempty"`
+}
+
+// Database types supported by Database.Type.
+const (
+	DatabasePostgreSQL = "postgresql"
+	DatabaseMySQL      = "mysql"
+	DatabaseMariaDB    = "mariadb"
+	DatabaseRedis      = "redis"
+)
+
+// Database is a desired database.
+type Database struct {
+	Name        string `yaml:"name" json:"name"`
+	Type        string `yaml:"type" json:"type"`
+	Project     string `yaml:"project" json:"project"`
+	Environment string `yaml:"environment,omitempty" json:"environment,omitempty"`
+	Server      string `yaml:"server" json:"server"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	Image       string `yaml:"image,omitempty" json:"image,omitempty"`
+	IsPublic    bool   `yaml:"is_public,omitempty" json:"is_public,omitempty"`
+	PublicPort  int    `yaml:"public_port,omitempty" json:"public_port,omitempty"`
+
+	LimitsMemory string `yaml:"limits_memory,omitempty" json:"limits_memory,omitempty"`
+	LimitsCpus   string `yaml:"limits_cpus,omitempty" json:"limits_cpus,omitempty"`
+}
+
+// Load reads a manifest file.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse decodes and validates a YAML or JSON manifest. Private key files are
+// read relative to the working directory.
+func Parse(data []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	if err := m.normalize(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// normalize applies defaults and checks names and references.
+func (m *Manifest) normalize() error {
+	keys := map[string]bool{}
+	for i := range m.PrivateKeys {
+		key := &m.PrivateKeys[i]
+		if err := unique(keys, "private key", key.Name); err != nil {
+			return err
+		}
+		if key.PrivateKey == "" && key.PrivateKeyFile != "" {
+			data, err := os.ReadFile(key.PrivateKeyFile)
+			if err != nil {
+				return fmt.Errorf("private key %q: %w", key.Name, err)
+			}
+			key.PrivateKey = string(data)
+		}
+		if key.PrivateKey == "" {
+			return fmt.Errorf("private key %q: private_key or private_key_file is required", key.Name)
+		}
+	}
+
+	servers := map[string]bool{}
+	for i := range m.Servers {
+		srv := &m.Servers[i]
+		if err := unique(servers, "server", srv.Name); err != nil {
+			return err
+		}
+		if srv.IP == "" || srv.PrivateKey == "" {
+			return fmt.Errorf("server %q: ip and private_key are required", srv.Name)
+		}
+		if srv.Port == 0 {
+			srv.Port = 22
+		}
+		if srv.User == "" {
+			srv.User = "root"
+		}
+	}
+
+	projects := map[string]bool{}
+	for i := range m.Projects {
+		project := &m.Projects[i]
+		if err := unique(projects, "project", project.Name); err != nil {
+			return err
+		}
+		if len(project.Environments) == 0 {
+			project.Environments = []string{"production"}
+		}
+	}
+
+	databases := map[string]bool{}
+	for i := range m.Databases {
+		db := &m.Databases[i]
+		if db.Environment == "" {
+			db.Environment = "production"
+		}
+		if err := unique(databases, "database", db.Project+"/"+db.Environment+"/"+db.Name); err != nil {
+			return err
+		}
+		switch db.Type {
+		case DatabasePostgreSQL, DatabaseMySQL, DatabaseMariaDB, DatabaseRedis:
+		default:
+			return fmt.Errorf("database %q: unsupported type %q", db.Name, db.Type)
+		}
+		if db.Project == "" || db.Server == "" {
+			return fmt.Errorf("database %q: project and server are required", db.Name)
+		}
+	}
+
+	return nil
+}
+
+func unique(seen map[string]bool, kind, name string) error {
+	if name == "" {
+		return fmt.Errorf("%s without a name", kind)
+	}
+	if seen[name] {
+		return fmt.Errorf("duplicate %s %q", kind, name)
+	}
+	seen[name] = true
+	return nil
+}
@@ -0,0 +1,465 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/database"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+// Action is what Apply does with a resource.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionNoop   Action = "no-op"
+)
+
+// Kind is the type of resource a Change applies to.
+type Kind string
+
+const (
+	KindPrivateKey  Kind = "private_key"
+	KindServer      Kind = "server"
+	KindProject     Kind = "project"
+	KindEnvironment Kind = "environment"
+	KindDatabase    Kind = "database"
+)
+
+// sensitive replaces secret values in diffs.
+const sensitive = "(sensitive)"
+
+// Diff is a field whose live value differs from the manifest.
+type Diff struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// Change is the planned action for one resource. Name is the manifest name;
+// environments are named "project/environment" and databases
+// "project/environment/name".
+type Change struct {
+	Kind   Kind
+	Name   string
+	UUID   string
+	Action Action
+	Diffs  []Diff
+
+	privateKey *PrivateKey
+	server     *Server
+	project    *Project
+	database   *Database
+}
+
+// Plan is the ordered list of changes computed by Engine.Plan.
+type Plan struct {
+	Changes []Change
+
+	// UUIDs of the live resources, by name, used to resolve references.
+	privateKeys map[string]string
+	servers     map[string]string
+	projects    map[string]string
+}
+
+// HasChanges reports whether applying the plan would change anything.
+func (p *Plan) HasChanges() bool {
+	for _, change := range p.Changes {
+		if change.Action != ActionNoop {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the plan for humans, one line per change followed by its
+// field diffs.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		symbol := map[Action]string{
+			ActionCreate: "+",
+			ActionUpdate: "~",
+			ActionDelete: "-",
+			ActionNoop:   " ",
+		}[change.Action]
+
+		fmt.Fprintf(&b, "%s %s %s\n", symbol, change.Kind, change.Name)
+		for _, diff := range change.Diffs {
+			fmt.Fprintf(&b, "    %s: %q => %q\n", diff.Field, diff.Old, diff.New)
+		}
+	}
+	return b.String()
+}
+
+// PlanOptions controls how a plan is computed.
+type PlanOptions struct {
+	// Prune deletes live resources that are not in the manifest. Private
+	// keys used by git sources are never pruned.
+	Prune bool
+}
+
+// Engine plans and applies manifests against a Coolify instance.
+type Engine struct {
+	PrivateKeys coolify_sdk.PrivateKeyAPI
+	Servers     coolify_sdk.ServerAPI
+	Projects    coolify_sdk.ProjectAPI
+	Databases   coolify_sdk.DatabaseAPI
+}
+
+// NewEngine creates an Engine that uses the SDK's resource APIs.
+func NewEngine(sdk *coolify_sdk.Sdk) *Engine {
+	return &Engine{
+		PrivateKeys: sdk.PrivateKey,
+		Servers:     sdk.Server,
+		Projects:    sdk.Project,
+		Databases:   sdk.Database,
+	}
+}
+
+// state is the live state of the instance, keyed by manifest names.
+type state struct {
+	privateKeys map[string]coolify_sdk.PrivateKey
+	keyNames    map[int]string
+	servers     map[string]server.Server
+	projects    map[string]coolify_sdk.Project
+	databases   map[string]database.Database
+}
+
+// Plan compares the manifest with the live state and returns the changes
+// needed to reconcile them, in the order Apply runs them: creates and
+// updates of keys, servers, projects, environments and databases, then
+// deletes in the reverse order.
+func (e *Engine) Plan(ctx context.Context, m *Manifest, opts PlanOptions) (*Plan, error) {
+	live, err := e.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{
+		privateKeys: map[string]string{},
+		servers:     map[string]string{},
+		projects:    map[string]string{},
+	}
+	for name, key := range live.privateKeys {
+		plan.privateKeys[name] = key.UUID
+	}
+	for name, srv := range live.servers {
+		plan.servers[name] = srv.UUID
+	}
+	for name, project := range live.projects {
+		plan.projects[name] = project.UUID
+	}
+
+	for i := range m.PrivateKeys {
+		plan.Changes = append(plan.Changes, planPrivateKey(&m.PrivateKeys[i], live))
+	}
+
+	for i := range m.Servers {
+		desired := &m.Servers[i]
+		if _, ok := plan.privateKeys[desired.PrivateKey]; !ok && !m.declares(KindPrivateKey, desired.PrivateKey) {
+			return nil, fmt.Errorf("server %q: unknown private key %q", desired.Name, desired.PrivateKey)
+		}
+		plan.Changes = append(plan.Changes, planServer(desired, live))
+	}
+
+	var environments []Change
+	for i := range m.Projects {
+		desired := &m.Projects[i]
+		plan.Changes = append(plan.Changes, planProject(desired, live))
+		environments = append(environments, planEnvironments(desired, live)...)
+	}
+	plan.Changes = append(plan.Changes, environments...)
+
+	for i := range m.Databases {
+		desired := &m.Databases[i]
+		if _, ok := plan.servers[desired.Server]; !ok && !m.declares(KindServer, desired.Server) {
+			return nil, fmt.Errorf("database %q: unknown server %q", desired.Name, desired.Server)
+		}
+		if _, ok := plan.projects[desired.Project]; !ok && !m.declares(KindProject, desired.Project) {
+			return nil, fmt.Errorf("database %q: unknown project %q", desired.Name, desired.Project)
+		}
+
+		change, err := planDatabase(desired, live)
+		if err != nil {
+			return nil, err
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	if opts.Prune {
+		plan.Changes = append(plan.Changes, prune(m, live)...)
+	}
+
+	return plan, nil
+}
+
+// load fetches the live state. Projects are fetched one by one because the
+// list endpoint does not include their environments.
+func (e *Engine) load(ctx context.Context) (*state, error) {
+	live := &state{
+		privateKeys: map[string]coolify_sdk.PrivateKey{},
+		keyNames:    map[int]string{},
+		servers:     map[string]server.Server{},
+		projects:    map[string]coolify_sdk.Project{},
+		databases:   map[string]database.Database{},
+	}
+
+	keys, err := e.PrivateKeys.ListWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list private keys: %w", err)
+	}
+	for _, key := range *keys {
+		live.privateKeys[key.Name] = key
+		live.keyNames[key.ID] = key.Name
+	}
+
+	servers, err := e.Servers.ListWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list servers: %w", err)
+	}
+	for _, srv := range *servers {
+		live.servers[srv.Name] = srv
+	}
+
+	projects, err := e.Projects.ListWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	environments := map[int]string{}
+	for _, listed := range *projects {
+		project, err := e.Projects.GetWithContext(ctx, listed.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project %s: %w", listed.UUID, err)
+		}
+		live.projects[project.Name] = *project
+		for _, environment := range project.Environments {
+			environments[int(environment.ID)] = project.Name + "/" + environment.Name
+		}
+	}
+
+	databases, err := e.Databases.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range *databases {
+		if path, ok := environments[db.EnvironmentID]; ok {
+			live.databases[path+"/"+db.Name] = db
+		}
+	}
+
+	return live, nil
+}
+
+func planPrivateKey(desired *PrivateKey, live *state) Change {
+	change := Change{Kind: KindPrivateKey, Name: desired.Name, Action: ActionCreate, privateKey: desired}
+
+	current, ok := live.privateKeys[desired.Name]
+	if !ok {
+		return change
+	}
+
+	change.UUID = current.UUID
+	change.Diffs = diff(nil, "description", current.Description, desired.Description)
+	if strings.TrimSpace(current.PrivateKey) != strings.TrimSpace(desired.PrivateKey) {
+		change.Diffs = append(change.Diffs, Diff{Field: "private_key", Old: sensitive, New: sensitive})
+	}
+	change.Action = action(change.Diffs)
+	return change
+}
+
+func planServer(desired *Server, live *state) Change {
+	change := Change{Kind: KindServer, Name: desired.Name, Action: ActionCreate, server: desired}
+
+	current, ok := live.servers[desired.Name]
+	if !ok {
+		return change
+	}
+
+	isBuildServer := current.Settings != nil && current.Settings.IsBuildServer
+
+	change.UUID = current.UUID
+	change.Diffs = diff(nil, "description", client.Deref(current.Description), desired.Description)
+	change.Diffs = diff(change.Diffs, "ip", current.IP, desired.IP)
+	change.Diffs = diff(change.Diffs, "port", strconv.Itoa(current.Port), strconv.Itoa(desired.Port))
+	change.Diffs = diff(change.Diffs, "user", current.User, desired.User)
+	change.Diffs = diff(change.Diffs, "private_key", live.keyNames[current.PrivateKeyID], desired.PrivateKey)
+	change.Diffs = diff(change.Diffs, "is_build_server", strconv.FormatBool(isBuildServer), strconv.FormatBool(desired.IsBuildServer))
+	change.Action = action(change.Diffs)
+	return change
+}
+
+func planProject(desired *Project, live *state) Change {
+	change := Change{Kind: KindProject, Name: desired.Name, Action: ActionCreate, project: desired}
+
+	current, ok := live.projects[desired.Name]
+	if !ok {
+		return change
+	}
+
+	change.UUID = current.UUID
+	change.Diffs = diff(nil, "description", client.Deref(current.Description), desired.Description)
+	change.Action = action(change.Diffs)
+	return change
+}
+
+// planEnvironments returns the environments missing from a project. Coolify
+// creates "production" with every project.
+func planEnvironments(desired *Project, live *state) []Change {
+	existing := map[string]bool{"production": true}
+	if current, ok := live.projects[desired.Name]; ok {
+		existing = map[string]bool{}
+		for _, environment := range current.Environments {
+			existing[environment.Name] = true
+		}
+	}
+
+	var changes []Change
+	for _, name := range desired.Environments {
+		action := ActionNoop
+		if !existing[name] {
+			action = ActionCreate
+		}
+		changes = append(changes, Change{Kind: KindEnvironment, Name: desired.Name + "/" + name, Action: action})
+	}
+	return changes
+}
+
+func planDatabase(desired *Database, live *state) (Change, error) {
+	name := desired.Project + "/" + desired.Environment + "/" + desired.Name
+	change := Change{Kind: KindDatabase, Name: name, Action: ActionCreate, database: desired}
+
+	current, ok := live.databases[name]
+	if !ok {
+		return change, nil
+	}
+
+	if current.DatabaseType != "standalone-"+desired.Type {
+		return Change{}, fmt.Errorf("database %q: cannot change type from %s to %s", name, current.DatabaseType, desired.Type)
+	}
+	if current.Destination.Server.Name != desired.Server {
+		return Change{}, fmt.Errorf("database %q: cannot move from server %q to %q", name, current.Destination.Server.Name, desired.Server)
+	}
+
+	change.UUID = current.UUID
+	change.Diffs = diff(nil, "description", client.Deref(current.Description), desired.Description)
+	change.Diffs = diff(change.Diffs, "is_public", strconv.FormatBool(current.IsPublic), strconv.FormatBool(desired.IsPublic))
+
+	// Optional settings are only compared when the manifest sets them, so
+	// that Coolify's defaults do not show up as drift.
+	if desired.Image != "" {
+		change.Diffs = diff(change.Diffs, "image", current.Image, desired.Image)
+	}
+	if desired.PublicPort != 0 {
+		change.Diffs = diff(change.Diffs, "public_port", strconv.Itoa(current.PublicPort), strconv.Itoa(desired.PublicPort))
+	}
+	if desired.LimitsMemory != "" {
+		change.Diffs = diff(change.Diffs, "limits_memory", current.LimitsMemory, desired.LimitsMemory)
+	}
+	if desired.LimitsCpus != "" {
+		change.Diffs = diff(change.Diffs, "limits_cpus", current.LimitsCpus, desired.LimitsCpus)
+	}
+
+	change.Action = action(change.Diffs)
+	return change, nil
+}
+
+// prune returns deletions for live resources missing from the manifest,
+// dependents first. Resources the manifest references without declaring
+// them, Coolify's own server and the private keys of servers that are kept
+// are never deleted, since Coolify refuses to delete the latter two.
+func prune(m *Manifest, live *state) []Change {
+	var changes []Change
+
+	wanted := map[string]bool{}
+	keptServers := map[string]bool{}
+	keptProjects := map[string]bool{}
+	keptKeyNames := map[string]bool{}
+	for _, db := range m.Databases {
+		wanted[db.Project+"/"+db.Environment+"/"+db.Name] = true
+		keptServers[db.Server] = true
+		keptProjects[db.Project] = true
+	}
+	for _, srv := range m.Servers {
+		keptServers[srv.Name] = true
+		keptKeyNames[srv.PrivateKey] = true
+	}
+	for _, project := range m.Projects {
+		keptProjects[project.Name] = true
+	}
+	for _, key := range m.PrivateKeys {
+		keptKeyNames[key.Name] = true
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(live.databases)) {
+		if !wanted[name] {
+			changes = append(changes, Change{Kind: KindDatabase, Name: name, UUID: live.databases[name].UUID, Action: ActionDelete})
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(live.projects)) {
+		if !keptProjects[name] {
+			changes = append(changes, Change{Kind: KindProject, Name: name, UUID: live.projects[name].UUID, Action: ActionDelete})
+		}
+	}
+
+	keptKeys := map[int]bool{}
+	for _, name := range slices.Sorted(maps.Keys(live.servers)) {
+		srv := live.servers[name]
+		if keptServers[name] || isLocalhost(srv) {
+			keptKeys[srv.PrivateKeyID] = true
+			continue
+		}
+		changes = append(changes, Change{Kind: KindServer, Name: name, UUID: srv.UUID, Action: ActionDelete})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(live.privateKeys)) {
+		key := live.privateKeys[name]
+		if !keptKeyNames[name] && !key.IsGitRelated && !keptKeys[key.ID] {
+			changes = append(changes, Change{Kind: KindPrivateKey, Name: name, UUID: key.UUID, Action: ActionDelete})
+		}
+	}
+
+	return changes
+}
+
+// isLocalhost reports whether srv is the server Coolify itself runs on.
+func isLocalhost(srv server.Server) bool {
+	return srv.Name == "localhost" || srv.IP == "host.docker.internal"
+}
+
+// declares reports whether the manifest has a resource of kind with name.
+func (m *Manifest) declares(kind Kind, name string) bool {
+	switch kind {
+	case KindPrivateKey:
+		return slices.ContainsFunc(m.PrivateKeys, func(k PrivateKey) bool { return k.Name == name })
+	case KindServer:
+		return slices.ContainsFunc(m.Servers, func(s Server) bool { return s.Name == name })
+	case KindProject:
+		return slices.ContainsFunc(m.Projects, func(p Project) bool { return p.Name == name })
+	}
+	return false
+}
+
+func diff(diffs []Diff, field, old, new string) []Diff {
+	if old == new {
+		return diffs
+	}
+	return append(diffs, Diff{Field: field, Old: old, New: new})
+}
+
+func action(diffs []Diff) Action {
+	if len(diffs) == 0 {
+		return ActionNoop
+	}
+	return ActionUpdate
+}
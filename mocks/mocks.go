@@ -0,0 +1,1538 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=mocks/mocks.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+	reflect "reflect"
+
+	coolify_sdk "github.com/marconneves/coolify-sdk-go"
+	database "github.com/marconneves/coolify-sdk-go/database"
+	destination "github.com/marconneves/coolify-sdk-go/destination"
+	server "github.com/marconneves/coolify-sdk-go/server"
+	storage "github.com/marconneves/coolify-sdk-go/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockServerAPI is a mock of ServerAPI interface.
+type MockServerAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockServerAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockServerAPIMockRecorder is the mock recorder for MockServerAPI.
+type MockServerAPIMockRecorder struct {
+	mock *MockServerAPI
+}
+
+// NewMockServerAPI creates a new mock instance.
+func NewMockServerAPI(ctrl *gomock.Controller) *MockServerAPI {
+	mock := &MockServerAPI{ctrl: ctrl}
+	mock.recorder = &MockServerAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServerAPI) EXPECT() *MockServerAPIMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockServerAPI) All(ctx context.Context) iter.Seq2[server.Server, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", ctx)
+	ret0, _ := ret[0].(iter.Seq2[server.Server, error])
+	return ret0
+}
+
+// All indicates an expected call of All.
+func (mr *MockServerAPIMockRecorder) All(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockServerAPI)(nil).All), ctx)
+}
+
+// Create mocks base method.
+func (m *MockServerAPI) Create(arg0 *server.CreateServerDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServerAPIMockRecorder) Create(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockServerAPI)(nil).Create), arg0)
+}
+
+// CreateWithContext mocks base method.
+func (m *MockServerAPI) CreateWithContext(ctx context.Context, arg1 *server.CreateServerDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithContext", ctx, arg1)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithContext indicates an expected call of CreateWithContext.
+func (mr *MockServerAPIMockRecorder) CreateWithContext(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithContext", reflect.TypeOf((*MockServerAPI)(nil).CreateWithContext), ctx, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockServerAPI) Delete(uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServerAPIMockRecorder) Delete(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServerAPI)(nil).Delete), uuid)
+}
+
+// DeleteWithContext mocks base method.
+func (m *MockServerAPI) DeleteWithContext(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithContext", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithContext indicates an expected call of DeleteWithContext.
+func (mr *MockServerAPIMockRecorder) DeleteWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithContext", reflect.TypeOf((*MockServerAPI)(nil).DeleteWithContext), ctx, uuid)
+}
+
+// Domains mocks base method.
+func (m *MockServerAPI) Domains(uuid string) (*[]server.Domain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Domains", uuid)
+	ret0, _ := ret[0].(*[]server.Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Domains indicates an expected call of Domains.
+func (mr *MockServerAPIMockRecorder) Domains(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Domains", reflect.TypeOf((*MockServerAPI)(nil).Domains), uuid)
+}
+
+// DomainsWithContext mocks base method.
+func (m *MockServerAPI) DomainsWithContext(ctx context.Context, uuid string) (*[]server.Domain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainsWithContext", ctx, uuid)
+	ret0, _ := ret[0].(*[]server.Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DomainsWithContext indicates an expected call of DomainsWithContext.
+func (mr *MockServerAPIMockRecorder) DomainsWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainsWithContext", reflect.TypeOf((*MockServerAPI)(nil).DomainsWithContext), ctx, uuid)
+}
+
+// Get mocks base method.
+func (m *MockServerAPI) Get(uuid string) (*server.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", uuid)
+	ret0, _ := ret[0].(*server.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockServerAPIMockRecorder) Get(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockServerAPI)(nil).Get), uuid)
+}
+
+// GetWithContext mocks base method.
+func (m *MockServerAPI) GetWithContext(ctx context.Context, uuid string) (*server.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithContext", ctx, uuid)
+	ret0, _ := ret[0].(*server.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithContext indicates an expected call of GetWithContext.
+func (mr *MockServerAPIMockRecorder) GetWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithContext", reflect.TypeOf((*MockServerAPI)(nil).GetWithContext), ctx, uuid)
+}
+
+// List mocks base method.
+func (m *MockServerAPI) List() (*[]server.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].(*[]server.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockServerAPIMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServerAPI)(nil).List))
+}
+
+// ListWithContext mocks base method.
+func (m *MockServerAPI) ListWithContext(ctx context.Context) (*[]server.Server, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithContext", ctx)
+	ret0, _ := ret[0].(*[]server.Server)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithContext indicates an expected call of ListWithContext.
+func (mr *MockServerAPIMockRecorder) ListWithContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithContext", reflect.TypeOf((*MockServerAPI)(nil).ListWithContext), ctx)
+}
+
+// Resources mocks base method.
+func (m *MockServerAPI) Resources(uuid string) (*[]server.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources", uuid)
+	ret0, _ := ret[0].(*[]server.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockServerAPIMockRecorder) Resources(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockServerAPI)(nil).Resources), uuid)
+}
+
+// ResourcesWithContext mocks base method.
+func (m *MockServerAPI) ResourcesWithContext(ctx context.Context, uuid string) (*[]server.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourcesWithContext", ctx, uuid)
+	ret0, _ := ret[0].(*[]server.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResourcesWithContext indicates an expected call of ResourcesWithContext.
+func (mr *MockServerAPIMockRecorder) ResourcesWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourcesWithContext", reflect.TypeOf((*MockServerAPI)(nil).ResourcesWithContext), ctx, uuid)
+}
+
+// Update mocks base method.
+func (m *MockServerAPI) Update(uuid string, arg1 *server.UpdateServerDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", uuid, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockServerAPIMockRecorder) Update(uuid, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockServerAPI)(nil).Update), uuid, arg1)
+}
+
+// UpdateWithContext mocks base method.
+func (m *MockServerAPI) UpdateWithContext(ctx context.Context, uuid string, arg2 *server.UpdateServerDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithContext", ctx, uuid, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithContext indicates an expected call of UpdateWithContext.
+func (mr *MockServerAPIMockRecorder) UpdateWithContext(ctx, uuid, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithContext", reflect.TypeOf((*MockServerAPI)(nil).UpdateWithContext), ctx, uuid, arg2)
+}
+
+// Validate mocks base method.
+func (m *MockServerAPI) Validate(uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockServerAPIMockRecorder) Validate(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockServerAPI)(nil).Validate), uuid)
+}
+
+// ValidateWithContext mocks base method.
+func (m *MockServerAPI) ValidateWithContext(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateWithContext", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateWithContext indicates an expected call of ValidateWithContext.
+func (mr *MockServerAPIMockRecorder) ValidateWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateWithContext", reflect.TypeOf((*MockServerAPI)(nil).ValidateWithContext), ctx, uuid)
+}
+
+// MockDatabaseAPI is a mock of DatabaseAPI interface.
+type MockDatabaseAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDatabaseAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockDatabaseAPIMockRecorder is the mock recorder for MockDatabaseAPI.
+type MockDatabaseAPIMockRecorder struct {
+	mock *MockDatabaseAPI
+}
+
+// NewMockDatabaseAPI creates a new mock instance.
+func NewMockDatabaseAPI(ctrl *gomock.Controller) *MockDatabaseAPI {
+	mock := &MockDatabaseAPI{ctrl: ctrl}
+	mock.recorder = &MockDatabaseAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDatabaseAPI) EXPECT() *MockDatabaseAPIMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockDatabaseAPI) All(ctx context.Context, filter database.Filter) iter.Seq2[database.Database, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", ctx, filter)
+	ret0, _ := ret[0].(iter.Seq2[database.Database, error])
+	return ret0
+}
+
+// All indicates an expected call of All.
+func (mr *MockDatabaseAPIMockRecorder) All(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockDatabaseAPI)(nil).All), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockDatabaseAPI) Create(ctx context.Context, data database.CreateDatabaseDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDatabaseAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDatabaseAPI)(nil).Create), ctx, data)
+}
+
+// CreateMariaDB mocks base method.
+func (m *MockDatabaseAPI) CreateMariaDB(ctx context.Context, data *database.CreateDatabaseMariaDBDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMariaDB", ctx, data)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMariaDB indicates an expected call of CreateMariaDB.
+func (mr *MockDatabaseAPIMockRecorder) CreateMariaDB(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMariaDB", reflect.TypeOf((*MockDatabaseAPI)(nil).CreateMariaDB), ctx, data)
+}
+
+// CreateMySQL mocks base method.
+func (m *MockDatabaseAPI) CreateMySQL(ctx context.Context, data *database.CreateDatabaseMySQLDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMySQL", ctx, data)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMySQL indicates an expected call of CreateMySQL.
+func (mr *MockDatabaseAPIMockRecorder) CreateMySQL(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMySQL", reflect.TypeOf((*MockDatabaseAPI)(nil).CreateMySQL), ctx, data)
+}
+
+// CreatePostgreSQL mocks base method.
+func (m *MockDatabaseAPI) CreatePostgreSQL(ctx context.Context, data *database.CreateDatabasePostgresDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePostgreSQL", ctx, data)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePostgreSQL indicates an expected call of CreatePostgreSQL.
+func (mr *MockDatabaseAPIMockRecorder) CreatePostgreSQL(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePostgreSQL", reflect.TypeOf((*MockDatabaseAPI)(nil).CreatePostgreSQL), ctx, data)
+}
+
+// CreateRedis mocks base method.
+func (m *MockDatabaseAPI) CreateRedis(ctx context.Context, data *database.CreateDatabaseRedisDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRedis", ctx, data)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRedis indicates an expected call of CreateRedis.
+func (mr *MockDatabaseAPIMockRecorder) CreateRedis(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRedis", reflect.TypeOf((*MockDatabaseAPI)(nil).CreateRedis), ctx, data)
+}
+
+// Delete mocks base method.
+func (m *MockDatabaseAPI) Delete(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDatabaseAPIMockRecorder) Delete(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDatabaseAPI)(nil).Delete), ctx, uuid)
+}
+
+// Get mocks base method.
+func (m *MockDatabaseAPI) Get(ctx context.Context, uuid string) (*database.Database, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, uuid)
+	ret0, _ := ret[0].(*database.Database)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDatabaseAPIMockRecorder) Get(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDatabaseAPI)(nil).Get), ctx, uuid)
+}
+
+// List mocks base method.
+func (m *MockDatabaseAPI) List(ctx context.Context) (*[]database.Database, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*[]database.Database)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDatabaseAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDatabaseAPI)(nil).List), ctx)
+}
+
+// Restart mocks base method.
+func (m *MockDatabaseAPI) Restart(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restart", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restart indicates an expected call of Restart.
+func (mr *MockDatabaseAPIMockRecorder) Restart(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockDatabaseAPI)(nil).Restart), ctx, uuid)
+}
+
+// RestartAndWait mocks base method.
+func (m *MockDatabaseAPI) RestartAndWait(ctx context.Context, uuid string, opts database.WaitOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartAndWait", ctx, uuid, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestartAndWait indicates an expected call of RestartAndWait.
+func (mr *MockDatabaseAPIMockRecorder) RestartAndWait(ctx, uuid, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartAndWait", reflect.TypeOf((*MockDatabaseAPI)(nil).RestartAndWait), ctx, uuid, opts)
+}
+
+// Start mocks base method.
+func (m *MockDatabaseAPI) Start(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockDatabaseAPIMockRecorder) Start(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockDatabaseAPI)(nil).Start), ctx, uuid)
+}
+
+// StartAndWait mocks base method.
+func (m *MockDatabaseAPI) StartAndWait(ctx context.Context, uuid string, opts database.WaitOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartAndWait", ctx, uuid, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartAndWait indicates an expected call of StartAndWait.
+func (mr *MockDatabaseAPIMockRecorder) StartAndWait(ctx, uuid, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAndWait", reflect.TypeOf((*MockDatabaseAPI)(nil).StartAndWait), ctx, uuid, opts)
+}
+
+// Stop mocks base method.
+func (m *MockDatabaseAPI) Stop(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockDatabaseAPIMockRecorder) Stop(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockDatabaseAPI)(nil).Stop), ctx, uuid)
+}
+
+// StopAndWait mocks base method.
+func (m *MockDatabaseAPI) StopAndWait(ctx context.Context, uuid string, opts database.WaitOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopAndWait", ctx, uuid, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopAndWait indicates an expected call of StopAndWait.
+func (mr *MockDatabaseAPIMockRecorder) StopAndWait(ctx, uuid, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopAndWait", reflect.TypeOf((*MockDatabaseAPI)(nil).StopAndWait), ctx, uuid, opts)
+}
+
+// Storages mocks base method.
+func (m *MockDatabaseAPI) Storages(uuid string) storage.StorageAPI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Storages", uuid)
+	ret0, _ := ret[0].(storage.StorageAPI)
+	return ret0
+}
+
+// Storages indicates an expected call of Storages.
+func (mr *MockDatabaseAPIMockRecorder) Storages(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Storages", reflect.TypeOf((*MockDatabaseAPI)(nil).Storages), uuid)
+}
+
+// Update mocks base method.
+func (m *MockDatabaseAPI) Update(ctx context.Context, uuid string, data *database.UpdateDatabaseDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, uuid, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDatabaseAPIMockRecorder) Update(ctx, uuid, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDatabaseAPI)(nil).Update), ctx, uuid, data)
+}
+
+// WaitForStatus mocks base method.
+func (m *MockDatabaseAPI) WaitForStatus(ctx context.Context, uuid string, desired database.Status, opts database.WaitOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForStatus", ctx, uuid, desired, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForStatus indicates an expected call of WaitForStatus.
+func (mr *MockDatabaseAPIMockRecorder) WaitForStatus(ctx, uuid, desired, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForStatus", reflect.TypeOf((*MockDatabaseAPI)(nil).WaitForStatus), ctx, uuid, desired, opts)
+}
+
+// MockDestinationAPI is a mock of DestinationAPI interface.
+type MockDestinationAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockDestinationAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockDestinationAPIMockRecorder is the mock recorder for MockDestinationAPI.
+type MockDestinationAPIMockRecorder struct {
+	mock *MockDestinationAPI
+}
+
+// NewMockDestinationAPI creates a new mock instance.
+func NewMockDestinationAPI(ctrl *gomock.Controller) *MockDestinationAPI {
+	mock := &MockDestinationAPI{ctrl: ctrl}
+	mock.recorder = &MockDestinationAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDestinationAPI) EXPECT() *MockDestinationAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDestinationAPI) Create(ctx context.Context, data *destination.CreateDestinationDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDestinationAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDestinationAPI)(nil).Create), ctx, data)
+}
+
+// Default mocks base method.
+func (m *MockDestinationAPI) Default(ctx context.Context, serverUUID string) (*destination.Destination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Default", ctx, serverUUID)
+	ret0, _ := ret[0].(*destination.Destination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Default indicates an expected call of Default.
+func (mr *MockDestinationAPIMockRecorder) Default(ctx, serverUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Default", reflect.TypeOf((*MockDestinationAPI)(nil).Default), ctx, serverUUID)
+}
+
+// Delete mocks base method.
+func (m *MockDestinationAPI) Delete(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDestinationAPIMockRecorder) Delete(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDestinationAPI)(nil).Delete), ctx, uuid)
+}
+
+// Get mocks base method.
+func (m *MockDestinationAPI) Get(ctx context.Context, uuid string) (*destination.Destination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, uuid)
+	ret0, _ := ret[0].(*destination.Destination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDestinationAPIMockRecorder) Get(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDestinationAPI)(nil).Get), ctx, uuid)
+}
+
+// List mocks base method.
+func (m *MockDestinationAPI) List(ctx context.Context, serverUUID string) (*[]destination.Destination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, serverUUID)
+	ret0, _ := ret[0].(*[]destination.Destination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDestinationAPIMockRecorder) List(ctx, serverUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDestinationAPI)(nil).List), ctx, serverUUID)
+}
+
+// MockStorageAPI is a mock of StorageAPI interface.
+type MockStorageAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageAPIMockRecorder is the mock recorder for MockStorageAPI.
+type MockStorageAPIMockRecorder struct {
+	mock *MockStorageAPI
+}
+
+// NewMockStorageAPI creates a new mock instance.
+func NewMockStorageAPI(ctrl *gomock.Controller) *MockStorageAPI {
+	mock := &MockStorageAPI{ctrl: ctrl}
+	mock.recorder = &MockStorageAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageAPI) EXPECT() *MockStorageAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStorageAPI) Create(ctx context.Context, data *storage.CreateStorageDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStorageAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStorageAPI)(nil).Create), ctx, data)
+}
+
+// Delete mocks base method.
+func (m *MockStorageAPI) Delete(ctx context.Context, storageUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, storageUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStorageAPIMockRecorder) Delete(ctx, storageUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorageAPI)(nil).Delete), ctx, storageUUID)
+}
+
+// List mocks base method.
+func (m *MockStorageAPI) List(ctx context.Context) (*[]storage.Storage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*[]storage.Storage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockStorageAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStorageAPI)(nil).List), ctx)
+}
+
+// Update mocks base method.
+func (m *MockStorageAPI) Update(ctx context.Context, storageUUID string, data *storage.UpdateStorageDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, storageUUID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStorageAPIMockRecorder) Update(ctx, storageUUID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStorageAPI)(nil).Update), ctx, storageUUID, data)
+}
+
+// MockS3StorageAPI is a mock of S3StorageAPI interface.
+type MockS3StorageAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockS3StorageAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockS3StorageAPIMockRecorder is the mock recorder for MockS3StorageAPI.
+type MockS3StorageAPIMockRecorder struct {
+	mock *MockS3StorageAPI
+}
+
+// NewMockS3StorageAPI creates a new mock instance.
+func NewMockS3StorageAPI(ctrl *gomock.Controller) *MockS3StorageAPI {
+	mock := &MockS3StorageAPI{ctrl: ctrl}
+	mock.recorder = &MockS3StorageAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockS3StorageAPI) EXPECT() *MockS3StorageAPIMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockS3StorageAPI) Create(ctx context.Context, data *storage.CreateS3StorageDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, data)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockS3StorageAPIMockRecorder) Create(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockS3StorageAPI)(nil).Create), ctx, data)
+}
+
+// Delete mocks base method.
+func (m *MockS3StorageAPI) Delete(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockS3StorageAPIMockRecorder) Delete(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockS3StorageAPI)(nil).Delete), ctx, uuid)
+}
+
+// Get mocks base method.
+func (m *MockS3StorageAPI) Get(ctx context.Context, uuid string) (*storage.S3Storage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, uuid)
+	ret0, _ := ret[0].(*storage.S3Storage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockS3StorageAPIMockRecorder) Get(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockS3StorageAPI)(nil).Get), ctx, uuid)
+}
+
+// List mocks base method.
+func (m *MockS3StorageAPI) List(ctx context.Context) (*[]storage.S3Storage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*[]storage.S3Storage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockS3StorageAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockS3StorageAPI)(nil).List), ctx)
+}
+
+// Test mocks base method.
+func (m *MockS3StorageAPI) Test(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Test", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Test indicates an expected call of Test.
+func (mr *MockS3StorageAPIMockRecorder) Test(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Test", reflect.TypeOf((*MockS3StorageAPI)(nil).Test), ctx, uuid)
+}
+
+// Update mocks base method.
+func (m *MockS3StorageAPI) Update(ctx context.Context, uuid string, data *storage.UpdateS3StorageDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, uuid, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockS3StorageAPIMockRecorder) Update(ctx, uuid, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockS3StorageAPI)(nil).Update), ctx, uuid, data)
+}
+
+// MockProjectAPI is a mock of ProjectAPI interface.
+type MockProjectAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectAPIMockRecorder is the mock recorder for MockProjectAPI.
+type MockProjectAPIMockRecorder struct {
+	mock *MockProjectAPI
+}
+
+// NewMockProjectAPI creates a new mock instance.
+func NewMockProjectAPI(ctrl *gomock.Controller) *MockProjectAPI {
+	mock := &MockProjectAPI{ctrl: ctrl}
+	mock.recorder = &MockProjectAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectAPI) EXPECT() *MockProjectAPIMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockProjectAPI) All(ctx context.Context) iter.Seq2[coolify_sdk.Project, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", ctx)
+	ret0, _ := ret[0].(iter.Seq2[coolify_sdk.Project, error])
+	return ret0
+}
+
+// All indicates an expected call of All.
+func (mr *MockProjectAPIMockRecorder) All(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockProjectAPI)(nil).All), ctx)
+}
+
+// Create mocks base method.
+func (m *MockProjectAPI) Create(project *coolify_sdk.CreateProjectDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", project)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectAPIMockRecorder) Create(project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectAPI)(nil).Create), project)
+}
+
+// CreateWithContext mocks base method.
+func (m *MockProjectAPI) CreateWithContext(ctx context.Context, project *coolify_sdk.CreateProjectDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithContext", ctx, project)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithContext indicates an expected call of CreateWithContext.
+func (mr *MockProjectAPIMockRecorder) CreateWithContext(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithContext", reflect.TypeOf((*MockProjectAPI)(nil).CreateWithContext), ctx, project)
+}
+
+// Delete mocks base method.
+func (m *MockProjectAPI) Delete(uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProjectAPIMockRecorder) Delete(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectAPI)(nil).Delete), uuid)
+}
+
+// DeleteWithContext mocks base method.
+func (m *MockProjectAPI) DeleteWithContext(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithContext", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithContext indicates an expected call of DeleteWithContext.
+func (mr *MockProjectAPIMockRecorder) DeleteWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithContext", reflect.TypeOf((*MockProjectAPI)(nil).DeleteWithContext), ctx, uuid)
+}
+
+// Environment mocks base method.
+func (m *MockProjectAPI) Environment(uuid, environment string) (*coolify_sdk.EnvironmentData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Environment", uuid, environment)
+	ret0, _ := ret[0].(*coolify_sdk.EnvironmentData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockProjectAPIMockRecorder) Environment(uuid, environment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockProjectAPI)(nil).Environment), uuid, environment)
+}
+
+// EnvironmentWithContext mocks base method.
+func (m *MockProjectAPI) EnvironmentWithContext(ctx context.Context, uuid, environment string) (*coolify_sdk.EnvironmentData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentWithContext", ctx, uuid, environment)
+	ret0, _ := ret[0].(*coolify_sdk.EnvironmentData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentWithContext indicates an expected call of EnvironmentWithContext.
+func (mr *MockProjectAPIMockRecorder) EnvironmentWithContext(ctx, uuid, environment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentWithContext", reflect.TypeOf((*MockProjectAPI)(nil).EnvironmentWithContext), ctx, uuid, environment)
+}
+
+// Get mocks base method.
+func (m *MockProjectAPI) Get(uuid string) (*coolify_sdk.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", uuid)
+	ret0, _ := ret[0].(*coolify_sdk.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProjectAPIMockRecorder) Get(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectAPI)(nil).Get), uuid)
+}
+
+// GetWithContext mocks base method.
+func (m *MockProjectAPI) GetWithContext(ctx context.Context, uuid string) (*coolify_sdk.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithContext", ctx, uuid)
+	ret0, _ := ret[0].(*coolify_sdk.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithContext indicates an expected call of GetWithContext.
+func (mr *MockProjectAPIMockRecorder) GetWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithContext", reflect.TypeOf((*MockProjectAPI)(nil).GetWithContext), ctx, uuid)
+}
+
+// List mocks base method.
+func (m *MockProjectAPI) List() (*[]coolify_sdk.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].(*[]coolify_sdk.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProjectAPIMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectAPI)(nil).List))
+}
+
+// ListWithContext mocks base method.
+func (m *MockProjectAPI) ListWithContext(ctx context.Context) (*[]coolify_sdk.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithContext", ctx)
+	ret0, _ := ret[0].(*[]coolify_sdk.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithContext indicates an expected call of ListWithContext.
+func (mr *MockProjectAPIMockRecorder) ListWithContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithContext", reflect.TypeOf((*MockProjectAPI)(nil).ListWithContext), ctx)
+}
+
+// Update mocks base method.
+func (m *MockProjectAPI) Update(uuid string, project *coolify_sdk.UpdateProjectDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", uuid, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockProjectAPIMockRecorder) Update(uuid, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProjectAPI)(nil).Update), uuid, project)
+}
+
+// UpdateWithContext mocks base method.
+func (m *MockProjectAPI) UpdateWithContext(ctx context.Context, uuid string, project *coolify_sdk.UpdateProjectDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithContext", ctx, uuid, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithContext indicates an expected call of UpdateWithContext.
+func (mr *MockProjectAPIMockRecorder) UpdateWithContext(ctx, uuid, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithContext", reflect.TypeOf((*MockProjectAPI)(nil).UpdateWithContext), ctx, uuid, project)
+}
+
+// MockPrivateKeyAPI is a mock of PrivateKeyAPI interface.
+type MockPrivateKeyAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivateKeyAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockPrivateKeyAPIMockRecorder is the mock recorder for MockPrivateKeyAPI.
+type MockPrivateKeyAPIMockRecorder struct {
+	mock *MockPrivateKeyAPI
+}
+
+// NewMockPrivateKeyAPI creates a new mock instance.
+func NewMockPrivateKeyAPI(ctrl *gomock.Controller) *MockPrivateKeyAPI {
+	mock := &MockPrivateKeyAPI{ctrl: ctrl}
+	mock.recorder = &MockPrivateKeyAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivateKeyAPI) EXPECT() *MockPrivateKeyAPIMockRecorder {
+	return m.recorder
+}
+
+// All mocks base method.
+func (m *MockPrivateKeyAPI) All(ctx context.Context) iter.Seq2[coolify_sdk.PrivateKey, error] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "All", ctx)
+	ret0, _ := ret[0].(iter.Seq2[coolify_sdk.PrivateKey, error])
+	return ret0
+}
+
+// All indicates an expected call of All.
+func (mr *MockPrivateKeyAPIMockRecorder) All(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "All", reflect.TypeOf((*MockPrivateKeyAPI)(nil).All), ctx)
+}
+
+// Create mocks base method.
+func (m *MockPrivateKeyAPI) Create(privateKey *coolify_sdk.CreatePrivateKeyDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", privateKey)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPrivateKeyAPIMockRecorder) Create(privateKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPrivateKeyAPI)(nil).Create), privateKey)
+}
+
+// CreateWithContext mocks base method.
+func (m *MockPrivateKeyAPI) CreateWithContext(ctx context.Context, privateKey *coolify_sdk.CreatePrivateKeyDTO) (*string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithContext", ctx, privateKey)
+	ret0, _ := ret[0].(*string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithContext indicates an expected call of CreateWithContext.
+func (mr *MockPrivateKeyAPIMockRecorder) CreateWithContext(ctx, privateKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithContext", reflect.TypeOf((*MockPrivateKeyAPI)(nil).CreateWithContext), ctx, privateKey)
+}
+
+// Delete mocks base method.
+func (m *MockPrivateKeyAPI) Delete(uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPrivateKeyAPIMockRecorder) Delete(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPrivateKeyAPI)(nil).Delete), uuid)
+}
+
+// DeleteWithContext mocks base method.
+func (m *MockPrivateKeyAPI) DeleteWithContext(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithContext", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithContext indicates an expected call of DeleteWithContext.
+func (mr *MockPrivateKeyAPIMockRecorder) DeleteWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithContext", reflect.TypeOf((*MockPrivateKeyAPI)(nil).DeleteWithContext), ctx, uuid)
+}
+
+// Get mocks base method.
+func (m *MockPrivateKeyAPI) Get(uuid string) (*coolify_sdk.PrivateKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", uuid)
+	ret0, _ := ret[0].(*coolify_sdk.PrivateKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPrivateKeyAPIMockRecorder) Get(uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPrivateKeyAPI)(nil).Get), uuid)
+}
+
+// GetWithContext mocks base method.
+func (m *MockPrivateKeyAPI) GetWithContext(ctx context.Context, uuid string) (*coolify_sdk.PrivateKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithContext", ctx, uuid)
+	ret0, _ := ret[0].(*coolify_sdk.PrivateKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithContext indicates an expected call of GetWithContext.
+func (mr *MockPrivateKeyAPIMockRecorder) GetWithContext(ctx, uuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithContext", reflect.TypeOf((*MockPrivateKeyAPI)(nil).GetWithContext), ctx, uuid)
+}
+
+// List mocks base method.
+func (m *MockPrivateKeyAPI) List() (*[]coolify_sdk.PrivateKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].(*[]coolify_sdk.PrivateKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPrivateKeyAPIMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPrivateKeyAPI)(nil).List))
+}
+
+// ListWithContext mocks base method.
+func (m *MockPrivateKeyAPI) ListWithContext(ctx context.Context) (*[]coolify_sdk.PrivateKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithContext", ctx)
+	ret0, _ := ret[0].(*[]coolify_sdk.PrivateKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithContext indicates an expected call of ListWithContext.
+func (mr *MockPrivateKeyAPIMockRecorder) ListWithContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithContext", reflect.TypeOf((*MockPrivateKeyAPI)(nil).ListWithContext), ctx)
+}
+
+// Update mocks base method.
+func (m *MockPrivateKeyAPI) Update(uuid string, privateKey *coolify_sdk.UpdatePrivateKeyDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", uuid, privateKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPrivateKeyAPIMockRecorder) Update(uuid, privateKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPrivateKeyAPI)(nil).Update), uuid, privateKey)
+}
+
+// UpdateWithContext mocks base method.
+func (m *MockPrivateKeyAPI) UpdateWithContext(ctx context.Context, uuid string, privateKey *coolify_sdk.UpdatePrivateKeyDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithContext", ctx, uuid, privateKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithContext indicates an expected call of UpdateWithContext.
+func (mr *MockPrivateKeyAPIMockRecorder) UpdateWithContext(ctx, uuid, privateKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithContext", reflect.TypeOf((*MockPrivateKeyAPI)(nil).UpdateWithContext), ctx, uuid, privateKey)
+}
+
+// MockGitHubAppAPI is a mock of GitHubAppAPI interface.
+type MockGitHubAppAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitHubAppAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockGitHubAppAPIMockRecorder is the mock recorder for MockGitHubAppAPI.
+type MockGitHubAppAPIMockRecorder struct {
+	mock *MockGitHubAppAPI
+}
+
+// NewMockGitHubAppAPI creates a new mock instance.
+func NewMockGitHubAppAPI(ctrl *gomock.Controller) *MockGitHubAppAPI {
+	mock := &MockGitHubAppAPI{ctrl: ctrl}
+	mock.recorder = &MockGitHubAppAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitHubAppAPI) EXPECT() *MockGitHubAppAPIMockRecorder {
+	return m.recorder
+}
+
+// Branches mocks base method.
+func (m *MockGitHubAppAPI) Branches(ctx context.Context, id int, owner, repo string) (*[]coolify_sdk.GitHubBranch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Branches", ctx, id, owner, repo)
+	ret0, _ := ret[0].(*[]coolify_sdk.GitHubBranch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Branches indicates an expected call of Branches.
+func (mr *MockGitHubAppAPIMockRecorder) Branches(ctx, id, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Branches", reflect.TypeOf((*MockGitHubAppAPI)(nil).Branches), ctx, id, owner, repo)
+}
+
+// Create mocks base method.
+func (m *MockGitHubAppAPI) Create(ctx context.Context, app *coolify_sdk.CreateGitHubAppDTO) (*coolify_sdk.GitHubApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, app)
+	ret0, _ := ret[0].(*coolify_sdk.GitHubApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGitHubAppAPIMockRecorder) Create(ctx, app any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGitHubAppAPI)(nil).Create), ctx, app)
+}
+
+// CreateWithKey mocks base method.
+func (m *MockGitHubAppAPI) CreateWithKey(ctx context.Context, app *coolify_sdk.CreateGitHubAppDTO, key *coolify_sdk.CreatePrivateKeyDTO) (*coolify_sdk.GitHubApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithKey", ctx, app, key)
+	ret0, _ := ret[0].(*coolify_sdk.GitHubApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithKey indicates an expected call of CreateWithKey.
+func (mr *MockGitHubAppAPIMockRecorder) CreateWithKey(ctx, app, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithKey", reflect.TypeOf((*MockGitHubAppAPI)(nil).CreateWithKey), ctx, app, key)
+}
+
+// Delete mocks base method.
+func (m *MockGitHubAppAPI) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGitHubAppAPIMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGitHubAppAPI)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockGitHubAppAPI) Get(ctx context.Context, id int) (*coolify_sdk.GitHubApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*coolify_sdk.GitHubApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGitHubAppAPIMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGitHubAppAPI)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockGitHubAppAPI) List(ctx context.Context) (*[]coolify_sdk.GitHubApp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].(*[]coolify_sdk.GitHubApp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGitHubAppAPIMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGitHubAppAPI)(nil).List), ctx)
+}
+
+// Repositories mocks base method.
+func (m *MockGitHubAppAPI) Repositories(ctx context.Context, id int) (*[]coolify_sdk.GitHubRepository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Repositories", ctx, id)
+	ret0, _ := ret[0].(*[]coolify_sdk.GitHubRepository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Repositories indicates an expected call of Repositories.
+func (mr *MockGitHubAppAPIMockRecorder) Repositories(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repositories", reflect.TypeOf((*MockGitHubAppAPI)(nil).Repositories), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockGitHubAppAPI) Update(ctx context.Context, id int, app *coolify_sdk.UpdateGitHubAppDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, app)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGitHubAppAPIMockRecorder) Update(ctx, id, app any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGitHubAppAPI)(nil).Update), ctx, id, app)
+}
+
+// MockTeamAPI is a mock of TeamAPI interface.
+type MockTeamAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamAPIMockRecorder
+	isgomock struct{}
+}
+
+// MockTeamAPIMockRecorder is the mock recorder for MockTeamAPI.
+type MockTeamAPIMockRecorder struct {
+	mock *MockTeamAPI
+}
+
+// NewMockTeamAPI creates a new mock instance.
+func NewMockTeamAPI(ctrl *gomock.Controller) *MockTeamAPI {
+	mock := &MockTeamAPI{ctrl: ctrl}
+	mock.recorder = &MockTeamAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamAPI) EXPECT() *MockTeamAPIMockRecorder {
+	return m.recorder
+}
+
+// Current mocks base method.
+func (m *MockTeamAPI) Current(ctx context.Context) (*coolify_sdk.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", ctx)
+	ret0, _ := ret[0].(*coolify_sdk.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockTeamAPIMockRecorder) Current(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockTeamAPI)(nil).Current), ctx)
+}
+
+// Get mocks base method.
+func (m *MockTeamAPI) Get(id int) (*coolify_sdk.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*coolify_sdk.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTeamAPIMockRecorder) Get(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTeamAPI)(nil).Get), id)
+}
+
+// GetWithContext mocks base method.
+func (m *MockTeamAPI) GetWithContext(ctx context.Context, id int) (*coolify_sdk.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithContext", ctx, id)
+	ret0, _ := ret[0].(*coolify_sdk.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithContext indicates an expected call of GetWithContext.
+func (mr *MockTeamAPIMockRecorder) GetWithContext(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithContext", reflect.TypeOf((*MockTeamAPI)(nil).GetWithContext), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockTeamAPI) List() (*[]coolify_sdk.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].(*[]coolify_sdk.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTeamAPIMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTeamAPI)(nil).List))
+}
+
+// ListWithContext mocks base method.
+func (m *MockTeamAPI) ListWithContext(ctx context.Context) (*[]coolify_sdk.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithContext", ctx)
+	ret0, _ := ret[0].(*[]coolify_sdk.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithContext indicates an expected call of ListWithContext.
+func (mr *MockTeamAPIMockRecorder) ListWithContext(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithContext", reflect.TypeOf((*MockTeamAPI)(nil).ListWithContext), ctx)
+}
+
+// Members mocks base method.
+func (m *MockTeamAPI) Members(id int) (*[]coolify_sdk.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Members", id)
+	ret0, _ := ret[0].(*[]coolify_sdk.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Members indicates an expected call of Members.
+func (mr *MockTeamAPIMockRecorder) Members(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Members", reflect.TypeOf((*MockTeamAPI)(nil).Members), id)
+}
+
+// MembersWithContext mocks base method.
+func (m *MockTeamAPI) MembersWithContext(ctx context.Context, id int) (*[]coolify_sdk.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MembersWithContext", ctx, id)
+	ret0, _ := ret[0].(*[]coolify_sdk.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MembersWithContext indicates an expected call of MembersWithContext.
+func (mr *MockTeamAPIMockRecorder) MembersWithContext(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MembersWithContext", reflect.TypeOf((*MockTeamAPI)(nil).MembersWithContext), ctx, id)
+}
@@ -0,0 +1,148 @@
+// Package otel instruments the Coolify SDK with OpenTelemetry traces and
+// metrics. It is a separate module so that the core SDK does not depend on
+// OpenTelemetry.
+//
+//	observer, err := otel.NewObserver()
+//	if err != nil {
+//		return err
+//	}
+//	sdk := coolify_sdk.Init(host, token, client.WithObserver(observer))
+package otel
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+const instrumentationName = "github.com/marconneves/coolify-sdk-go/otel"
+
+// Attribute keys recorded on spans and metrics.
+const (
+	OperationKey  = attribute.Key("coolify.operation")
+	MethodKey     = attribute.Key("http.request.method")
+	URLPathKey    = attribute.Key("url.path")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+	RetriesKey    = attribute.Key("http.request.resend_count")
+)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures an Observer.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider. Defaults to the global one.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the MeterProvider. Defaults to the global one.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+// Observer implements client.Observer, creating a span per API call named
+// after the SDK method making it, such as coolify.database.CreatePostgreSQL,
+// and recording request latency, request and error counters.
+type Observer struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+}
+
+// NewObserver creates an Observer to be passed to client.WithObserver.
+func NewObserver(opts ...Option) (*Observer, error) {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	duration, err := meter.Float64Histogram("coolify.client.request.duration",
+		metric.WithDescription("Duration of Coolify API calls, including retries."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := meter.Int64Counter("coolify.client.requests",
+		metric.WithDescription("Number of Coolify API calls."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	errors, err := meter.Int64Counter("coolify.client.errors",
+		metric.WithDescription("Number of Coolify API calls that returned an error."),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Observer{
+		tracer:   cfg.tracerProvider.Tracer(instrumentationName),
+		duration: duration,
+		requests: requests,
+		errors:   errors,
+	}, nil
+}
+
+var _ client.Observer = (*Observer)(nil)
+
+// Start implements client.Observer.
+func (o *Observer) Start(ctx context.Context, req client.Request) (context.Context, func(client.Result)) {
+	operation := req.Operation
+	attrs := []attribute.KeyValue{
+		OperationKey.String(operation),
+		MethodKey.String(req.Method),
+		URLPathKey.String(req.Path),
+	}
+
+	ctx, span := o.tracer.Start(ctx, "coolify."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+	start := time.Now()
+
+	return ctx, func(result client.Result) {
+		metricAttrs := []attribute.KeyValue{
+			OperationKey.String(operation),
+			MethodKey.String(req.Method),
+		}
+		if result.StatusCode != 0 {
+			span.SetAttributes(StatusCodeKey.Int(result.StatusCode))
+			metricAttrs = append(metricAttrs, StatusCodeKey.Int(result.StatusCode))
+		}
+		span.SetAttributes(RetriesKey.Int(result.Retries))
+
+		set := metric.WithAttributes(metricAttrs...)
+		o.duration.Record(ctx, time.Since(start).Seconds(), set)
+		o.requests.Add(ctx, 1, set)
+
+		if result.Err != nil {
+			o.errors.Add(ctx, 1, set)
+			span.RecordError(result.Err)
+			span.SetStatus(codes.Error, result.Err.Error())
+		}
+
+		span.End()
+	}
+}
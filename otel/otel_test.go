@@ -0,0 +1,174 @@
+package otel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	coolifyotel "github.com/marconneves/coolify-sdk-go/otel"
+)
+
+func TestOtelObserver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/databases/postgresql":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"uuid":"pg1"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Not found."}`))
+		}
+	}))
+	defer server.Close()
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	observer, err := coolifyotel.NewObserver(
+		coolifyotel.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		coolifyotel.WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+	)
+	if err != nil {
+		t.Fatalf("NewObserver failed: %v", err)
+	}
+
+	client := sdk.Init(server.URL, "token", client.WithObserver(observer))
+
+	if _, err := client.Database.CreatePostgreSQL(context.Background(), &sdk.CreateDatabasePostgresDTO{}); err != nil {
+		t.Fatalf("CreatePostgreSQL failed: %v", err)
+	}
+	if _, err := client.Database.Get(context.Background(), "missing"); err == nil {
+		t.Fatalf("Get did not error")
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(ended))
+	}
+
+	cases := []struct {
+		Name   string
+		Status int64
+		Path   string
+	}{
+		{Name: "coolify.database.CreatePostgreSQL", Status: http.StatusCreated, Path: "databases/postgresql"},
+		{Name: "coolify.database.Get", Status: http.StatusNotFound, Path: "databases/missing"},
+	}
+
+	for i, expected := range cases {
+		span := ended[i]
+		if span.Name() != expected.Name {
+			t.Errorf("span %d: expected name %s, got %s", i, expected.Name, span.Name())
+		}
+
+		attrs := attribute.NewSet(span.Attributes()...)
+		if status, _ := attrs.Value(coolifyotel.StatusCodeKey); status.AsInt64() != expected.Status {
+			t.Errorf("span %d: expected status %d, got %d", i, expected.Status, status.AsInt64())
+		}
+		if path, _ := attrs.Value(coolifyotel.URLPathKey); path.AsString() != expected.Path {
+			t.Errorf("span %d: expected path %q, got %q", i, expected.Path, path.AsString())
+		}
+	}
+
+	var metrics metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &metrics); err != nil {
+		t.Fatalf("Collect failed: %v", err)
+	}
+
+	totals := map[string]int64{}
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if sum, ok := m.Data.(metricdata.Sum[int64]); ok {
+				for _, point := range sum.DataPoints {
+					totals[m.Name] += point.Value
+				}
+			}
+		}
+	}
+
+	if totals["coolify.client.requests"] != 2 || totals["coolify.client.errors"] != 1 {
+		t.Errorf("unexpected counters: %v", totals)
+	}
+}
+
+func TestOtelOperationNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/version":
+			w.Write([]byte(`4.0.0-beta.420`))
+		default:
+			w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	cases := map[string]struct {
+		Call func(*sdk.Sdk) error
+		Name string
+	}{
+		"Root": {
+			Call: func(c *sdk.Sdk) error { _, err := c.Project.ListWithContext(ctx); return err },
+			Name: "coolify.project.List",
+		},
+		"Acronym": {
+			Call: func(c *sdk.Sdk) error { _, err := c.GitHubApp.List(ctx); return err },
+			Name: "coolify.github_app.List",
+		},
+		"Sdk": {
+			Call: func(c *sdk.Sdk) error { _, err := c.Version(ctx); return err },
+			Name: "coolify.sdk.Version",
+		},
+		"Storage": {
+			Call: func(c *sdk.Sdk) error { _, err := c.Database.Storages("db1").List(ctx); return err },
+			Name: "coolify.storage.List",
+		},
+		"Client": {
+			Call: func(c *sdk.Sdk) error {
+				body, err := c.Client.HttpRequestWithContext(ctx, "servers", "GET")
+				if err == nil {
+					body.Close()
+				}
+				return err
+			},
+			Name: "coolify.request",
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			spans := tracetest.NewSpanRecorder()
+			observer, err := coolifyotel.NewObserver(
+				coolifyotel.WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The cache loads GETs on another goroutine.
+			cache := client.NewCache(client.CacheOptions{TTL: time.Minute})
+			c := sdk.Init(server.URL, "token", client.WithObserver(observer), client.WithCache(cache))
+			if err := testComponent.Call(c); err != nil {
+				t.Fatal(err)
+			}
+
+			ended := spans.Ended()
+			if len(ended) != 1 || ended[0].Name() != testComponent.Name {
+				for _, span := range ended {
+					t.Logf("span %s", span.Name())
+				}
+				t.Errorf("expected a single %s span", testComponent.Name)
+			}
+		})
+	}
+}
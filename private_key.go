@@ -1,109 +1,158 @@
-package coolify_sdk
-
-import (
-	"errors"
-	"fmt"
-	"time"
-
-	client "github.com/marconneves/coolify-sdk-go/client"
-)
-
-type PrivateKeyInstance struct {
-	client *client.Client
-}
-
-type PrivateKey struct {
-	ID           int       `json:"id"`
-	UUID         string    `json:"uuid"`
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	PrivateKey   string    `json:"private_key"`
-	IsGitRelated bool      `json:"is_git_related"`
-	TeamID       int       `json:"team_id"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
-}
-
-func (t *PrivateKeyInstance) List() (*[]PrivateKey, error) {
-	body, err := t.client.HttpRequest("security/keys", "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &[]PrivateKey{})
-}
-
-func (t *PrivateKeyInstance) Get(uuid string) (*PrivateKey, error) {
-	if uuid == "" {
-		return nil, errors.New("uuid is required")
-	}
-
-	body, err := t.client.HttpRequest(fmt.Sprintf("security/keys/%v", uuid), "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &PrivateKey{})
-}
-
-type CreatePrivateKeyDTO struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description,omitempty"`
-	PrivateKey  string  `json:"private_key"`
-}
-
-type CreatePrivateKeyResponse struct {
-	UUID string `json:"uuid"`
-}
-
-func (t *PrivateKeyInstance) Create(server *CreatePrivateKeyDTO) (*string, error) {
-	buf, err := client.EncodeRequest(server)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := t.client.HttpRequest("security/keys", "POST", *buf)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := client.DecodeResponse(body, &CreatePrivateKeyResponse{})
-	if err != nil {
-		return nil, err
-	}
-
-	return &response.UUID, nil
-}
-
-func (t *PrivateKeyInstance) Delete(uuid string) error {
-	if uuid == "" {
-		return errors.New("uuid is required")
-	}
-
-	_, err := t.client.HttpRequest(fmt.Sprintf("security/keys/%v", uuid), "DELETE")
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-type UpdatePrivateKeyDTO struct {
-	Description *string `json:"description,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	PrivateKey  *string `json:"private_key,omitempty"`
-}
-
-func (t *PrivateKeyInstance) Update(uuid string, privateKey *UpdatePrivateKeyDTO) error {
-	if uuid == "" {
-		return errors.New("uuid is required")
-	}
-
-	buf, err := client.EncodeRequest(privateKey)
-	if err != nil {
-		return err
-	}
-
-	_, err = t.client.HttpRequest(fmt.Sprintf("security/keys/%v", uuid), "PATCH", *buf)
-	return err
-}
+package coolify_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+)
+
+type PrivateKeyInstance struct {
+	client *client.Client
+}
+
+type PrivateKey struct {
+	ID           int       `json:"id"`
+	UUID         string    `json:"uuid"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	PrivateKey   string    `json:"private_key"`
+	IsGitRelated bool      `json:"is_git_related"`
+	TeamID       int       `json:"team_id"`
+	CreatedAt    Timestamp `json:"created_at"`
+	UpdatedAt    Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// List retrieves all private keys.
+// Deprecated: Use ListWithContext instead.
+func (t *PrivateKeyInstance) List() (*[]PrivateKey, error) {
+	return t.ListWithContext(context.Background())
+}
+
+// ListWithContext retrieves all private keys.
+func (t *PrivateKeyInstance) ListWithContext(ctx context.Context) (*[]PrivateKey, error) {
+	body, err := t.client.HttpRequestWithContext(ctx, "security/keys", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &[]PrivateKey{})
+}
+
+// All iterates over all private keys.
+func (t *PrivateKeyInstance) All(ctx context.Context) iter.Seq2[PrivateKey, error] {
+	return client.Iterate[PrivateKey](ctx, t.client, "security/keys", nil)
+}
+
+// Get retrieves a private key by UUID.
+// Deprecated: Use GetWithContext instead.
+func (t *PrivateKeyInstance) Get(uuid string) (*PrivateKey, error) {
+	return t.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext retrieves a private key by UUID.
+func (t *PrivateKeyInstance) GetWithContext(ctx context.Context, uuid string) (*PrivateKey, error) {
+	if uuid == "" {
+		return nil, errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("security/keys/%v", uuid), "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &PrivateKey{})
+}
+
+type CreatePrivateKeyDTO struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	PrivateKey  string  `json:"private_key"`
+}
+
+type CreatePrivateKeyResponse struct {
+	UUID string `json:"uuid"`
+}
+
+// Create creates a private key and returns its UUID.
+// Deprecated: Use CreateWithContext instead.
+func (t *PrivateKeyInstance) Create(server *CreatePrivateKeyDTO) (*string, error) {
+	return t.CreateWithContext(context.Background(), server)
+}
+
+// CreateWithContext creates a private key and returns its UUID.
+func (t *PrivateKeyInstance) CreateWithContext(ctx context.Context, server *CreatePrivateKeyDTO) (*string, error) {
+	buf, err := client.EncodeRequest(server)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, "security/keys", "POST", *buf)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.DecodeResponse(body, &CreatePrivateKeyResponse{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.UUID, nil
+}
+
+// Delete removes a private key.
+// Deprecated: Use DeleteWithContext instead.
+func (t *PrivateKeyInstance) Delete(uuid string) error {
+	return t.DeleteWithContext(context.Background(), uuid)
+}
+
+// DeleteWithContext removes a private key.
+func (t *PrivateKeyInstance) DeleteWithContext(ctx context.Context, uuid string) error {
+	if uuid == "" {
+		return errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("security/keys/%v", uuid), "DELETE")
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+type UpdatePrivateKeyDTO struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	PrivateKey  *string `json:"private_key,omitempty"`
+}
+
+// Update updates a private key.
+// Deprecated: Use UpdateWithContext instead.
+func (t *PrivateKeyInstance) Update(uuid string, privateKey *UpdatePrivateKeyDTO) error {
+	return t.UpdateWithContext(context.Background(), uuid, privateKey)
+}
+
+// UpdateWithContext updates a private key.
+func (t *PrivateKeyInstance) UpdateWithContext(ctx context.Context, uuid string, privateKey *UpdatePrivateKeyDTO) error {
+	if uuid == "" {
+		return errors.New("uuid is required")
+	}
+
+	buf, err := client.EncodeRequest(privateKey)
+	if err != nil {
+		return err
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("security/keys/%v", uuid), "PATCH", *buf)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
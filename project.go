@@ -1,134 +1,194 @@
-package coolify_sdk
-
-import (
-	"errors"
-	"fmt"
-	"time"
-
-	client "github.com/marconneves/coolify-sdk-go/client"
-)
-
-type ProjectInstance struct {
-	client *client.Client
-}
-
-type Project struct {
-	ID           int64         `json:"id"`
-	UUID         string        `json:"uuid"`
-	Name         string        `json:"name"`
-	Description  *string       `json:"description"`
-	Environments []Environment `json:"environments"`
-}
-
-type Environment struct {
-	ID          int64     `json:"id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description"`
-	ProjectId   int64     `json:"project_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-}
-
-func (t *ProjectInstance) List() (*[]Project, error) {
-	body, err := t.client.HttpRequest("projects", "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &[]Project{})
-}
-
-func (t *ProjectInstance) Get(uuid string) (*Project, error) {
-	if uuid == "" {
-		return nil, errors.New("uuid is required")
-	}
-
-	body, err := t.client.HttpRequest(fmt.Sprintf("projects/%v", uuid), "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &Project{})
-}
-
-type CreateProjectDTO struct {
-	Description *string `json:"description,omitempty"`
-	Name        *string `json:"name,omitempty"`
-}
-
-type CreateProjectResponse struct {
-	UUID string `json:"uuid"`
-}
-
-func (t *ProjectInstance) Create(server *CreateProjectDTO) (*string, error) {
-	buf, err := client.EncodeRequest(server)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := t.client.HttpRequest("projects", "POST", *buf)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := client.DecodeResponse(body, &CreateProjectResponse{})
-	if err != nil {
-		return nil, err
-	}
-
-	return &response.UUID, nil
-}
-
-func (t *ProjectInstance) Delete(uuid string) error {
-	if uuid == "" {
-		return errors.New("uuid is required")
-	}
-
-	_, err := t.client.HttpRequest(fmt.Sprintf("projects/%v", uuid), "DELETE")
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-type UpdateProjectDTO struct {
-	Description *string `json:"description,omitempty"`
-	Name        *string `json:"name,omitempty"`
-}
-
-func (t *ProjectInstance) Update(uuid string, server *UpdateProjectDTO) error {
-	if uuid == "" {
-		return errors.New("uuid is required")
-	}
-
-	buf, err := client.EncodeRequest(server)
-	if err != nil {
-		return err
-	}
-
-	_, err = t.client.HttpRequest(fmt.Sprintf("projects/%v", uuid), "PATCH", *buf)
-	return err
-}
-
-type EnvironmentData struct {
-	Id          int64     `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description"`
-	ProjectID   int64     `json:"project_id"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-}
-
-func (t *ProjectInstance) Environment(uuid string, environment string) (*EnvironmentData, error) {
-	if uuid == "" {
-		return nil, errors.New("uuid is required")
-	}
-
-	body, err := t.client.HttpRequest(fmt.Sprintf("projects/%v/%v/", uuid, environment), "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &EnvironmentData{})
-}
+package coolify_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+)
+
+type ProjectInstance struct {
+	client *client.Client
+}
+
+type Project struct {
+	ID           int64         `json:"id"`
+	UUID         string        `json:"uuid"`
+	Name         string        `json:"name"`
+	Description  *string       `json:"description"`
+	Environments []Environment `json:"environments"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type Environment struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	ProjectId   int64     `json:"project_id"`
+	CreatedAt   Timestamp `json:"created_at"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// List retrieves all projects.
+// Deprecated: Use ListWithContext instead.
+func (t *ProjectInstance) List() (*[]Project, error) {
+	return t.ListWithContext(context.Background())
+}
+
+// ListWithContext retrieves all projects.
+func (t *ProjectInstance) ListWithContext(ctx context.Context) (*[]Project, error) {
+	body, err := t.client.HttpRequestWithContext(ctx, "projects", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &[]Project{})
+}
+
+// All iterates over all projects.
+func (t *ProjectInstance) All(ctx context.Context) iter.Seq2[Project, error] {
+	return client.Iterate[Project](ctx, t.client, "projects", nil)
+}
+
+// Get retrieves a project by UUID.
+// Deprecated: Use GetWithContext instead.
+func (t *ProjectInstance) Get(uuid string) (*Project, error) {
+	return t.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext retrieves a project by UUID.
+func (t *ProjectInstance) GetWithContext(ctx context.Context, uuid string) (*Project, error) {
+	if uuid == "" {
+		return nil, errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("projects/%v", uuid), "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &Project{})
+}
+
+type CreateProjectDTO struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+type CreateProjectResponse struct {
+	UUID string `json:"uuid"`
+}
+
+// Create creates a project and returns its UUID.
+// Deprecated: Use CreateWithContext instead.
+func (t *ProjectInstance) Create(server *CreateProjectDTO) (*string, error) {
+	return t.CreateWithContext(context.Background(), server)
+}
+
+// CreateWithContext creates a project and returns its UUID.
+func (t *ProjectInstance) CreateWithContext(ctx context.Context, server *CreateProjectDTO) (*string, error) {
+	buf, err := client.EncodeRequest(server)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, "projects", "POST", *buf)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.DecodeResponse(body, &CreateProjectResponse{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.UUID, nil
+}
+
+// Delete removes a project.
+// Deprecated: Use DeleteWithContext instead.
+func (t *ProjectInstance) Delete(uuid string) error {
+	return t.DeleteWithContext(context.Background(), uuid)
+}
+
+// DeleteWithContext removes a project.
+func (t *ProjectInstance) DeleteWithContext(ctx context.Context, uuid string) error {
+	if uuid == "" {
+		return errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("projects/%v", uuid), "DELETE")
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+type UpdateProjectDTO struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// Update updates a project.
+// Deprecated: Use UpdateWithContext instead.
+func (t *ProjectInstance) Update(uuid string, server *UpdateProjectDTO) error {
+	return t.UpdateWithContext(context.Background(), uuid, server)
+}
+
+// UpdateWithContext updates a project.
+func (t *ProjectInstance) UpdateWithContext(ctx context.Context, uuid string, server *UpdateProjectDTO) error {
+	if uuid == "" {
+		return errors.New("uuid is required")
+	}
+
+	buf, err := client.EncodeRequest(server)
+	if err != nil {
+		return err
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("projects/%v", uuid), "PATCH", *buf)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+type EnvironmentData struct {
+	Id          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	ProjectID   int64     `json:"project_id"`
+	CreatedAt   Timestamp `json:"created_at"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Environment retrieves an environment of a project by name.
+// Deprecated: Use EnvironmentWithContext instead.
+func (t *ProjectInstance) Environment(uuid string, environment string) (*EnvironmentData, error) {
+	return t.EnvironmentWithContext(context.Background(), uuid, environment)
+}
+
+// EnvironmentWithContext retrieves an environment of a project by name.
+func (t *ProjectInstance) EnvironmentWithContext(ctx context.Context, uuid string, environment string) (*EnvironmentData, error) {
+	if uuid == "" {
+		return nil, errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("projects/%v/%v/", uuid, environment), "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &EnvironmentData{})
+}
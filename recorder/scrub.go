@@ -0,0 +1,124 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces scrubbed values in cassettes.
+const Redacted = "REDACTED"
+
+// secretFields are JSON keys whose values are always scrubbed, in addition
+// to any key ending in "_password", "_secret", "_key" or "_token".
+var secretFields = map[string]bool{
+	"private_key":   true,
+	"password":      true,
+	"token":         true,
+	"api_token":     true,
+	"metrics_token": true,
+	"client_secret": true,
+	"secret":        true,
+	"secret_key":    true,
+	"access_key":    true,
+	"key":           true,
+}
+
+func isSecretField(name string) bool {
+	if secretFields[name] {
+		return true
+	}
+	for _, suffix := range []string{"_password", "_secret", "_key", "_token"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isURLField reports whether name holds a connection URL, such as
+// internal_db_url, whose userinfo carries credentials.
+func isURLField(name string) bool {
+	return strings.HasSuffix(name, "_db_url")
+}
+
+// scrubURL redacts the userinfo of a connection URL. Values that do not
+// parse are redacted entirely.
+func scrubURL(value string) string {
+	u, err := url.Parse(value)
+	if err != nil {
+		return Redacted
+	}
+	if u.User != nil {
+		u.User = url.User(Redacted)
+	}
+	return u.String()
+}
+
+var keptHeaders = []string{"Content-Type", "Retry-After", "X-Ratelimit-Limit", "X-Ratelimit-Remaining"}
+
+// scrubHeaders keeps the headers relevant to the SDK and redacts the bearer
+// token.
+func scrubHeaders(header http.Header) http.Header {
+	scrubbed := http.Header{}
+	for _, name := range keptHeaders {
+		if values := header.Values(name); len(values) > 0 {
+			scrubbed[name] = append([]string(nil), values...)
+		}
+	}
+	if header.Get("Authorization") != "" {
+		scrubbed.Set("Authorization", "Bearer "+Redacted)
+	}
+
+	if len(scrubbed) == 0 {
+		return nil
+	}
+	return scrubbed
+}
+
+// ScrubJSON redacts secret fields and the credentials of connection URLs at
+// any depth of a JSON document. It returns nil when body is empty or not
+// JSON.
+func ScrubJSON(body []byte) json.RawMessage {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil
+	}
+
+	scrubbed, err := json.Marshal(scrubValue(v))
+	if err != nil {
+		return nil
+	}
+	return scrubbed
+}
+
+func scrubValue(v any) any {
+	switch value := v.(type) {
+	case map[string]any:
+		for key, field := range value {
+			if isSecretField(key) && field != nil {
+				value[key] = Redacted
+				continue
+			}
+			if raw, ok := field.(string); ok && isURLField(key) {
+				value[key] = scrubURL(raw)
+				continue
+			}
+			value[key] = scrubValue(field)
+		}
+	case []any:
+		for i, item := range value {
+			value[i] = scrubValue(item)
+		}
+	}
+	return v
+}
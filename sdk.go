@@ -1,64 +1,86 @@
-package coolify_sdk
-
-import (
-	"bytes"
-	"io"
-	"net/http"
-
-	client "github.com/marconneves/coolify-sdk-go/client"
-
-	database "github.com/marconneves/coolify-sdk-go/database"
-	server "github.com/marconneves/coolify-sdk-go/server"
-)
-
-type Sdk struct {
-	Client     client.Client
-	httpClient *http.Client
-
-	Api        *ApiInstance
-	Team       *TeamInstance
-	Server     *server.ServerInstance
-	PrivateKey *PrivateKeyInstance
-	Project    *ProjectInstance
-	Database   *database.DatabaseInstance
-}
-
-func Init(hostname string, apiToken string) *Sdk {
-	sdk := &Sdk{
-		httpClient: &http.Client{},
-	}
-
-	sdk.Client = *client.NewClient(hostname, apiToken)
-
-	sdk.Api = &ApiInstance{client: &sdk.Client}
-	sdk.Team = &TeamInstance{client: &sdk.Client}
-	sdk.Server = server.NewServer(&sdk.Client)
-	sdk.Database = database.NewDatabaseInstance(&sdk.Client)
-	sdk.PrivateKey = &PrivateKeyInstance{client: &sdk.Client}
-	sdk.Project = &ProjectInstance{client: &sdk.Client}
-
-	return sdk
-}
-
-func (c *Sdk) HeathCheck() (*string, error) {
-	body, err := c.Client.HttpRequest("healthcheck", "GET", bytes.Buffer{})
-	if err != nil {
-		return nil, err
-	}
-	defer body.Close()
-
-	bodyBytes, err := io.ReadAll(body)
-	if err != nil {
-		return nil, err
-	}
-
-	var healCheckResponse string
-
-	if string(bodyBytes) == "OK" {
-		healCheckResponse = "success"
-	} else {
-		healCheckResponse = "failure"
-	}
-
-	return &healCheckResponse, nil
-}
+package coolify_sdk
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+
+	database "github.com/marconneves/coolify-sdk-go/database"
+	destination "github.com/marconneves/coolify-sdk-go/destination"
+	server "github.com/marconneves/coolify-sdk-go/server"
+	storage "github.com/marconneves/coolify-sdk-go/storage"
+)
+
+type Sdk struct {
+	Client     client.Client
+	httpClient *http.Client
+
+	Api         *ApiInstance
+	Team        TeamAPI
+	Server      ServerAPI
+	Destination DestinationAPI
+	PrivateKey  PrivateKeyAPI
+	GitHubApp   GitHubAppAPI
+	Project     ProjectAPI
+	Database    DatabaseAPI
+	S3Storage   S3StorageAPI
+}
+
+func Init(hostname string, apiToken string, opts ...client.Option) *Sdk {
+	sdk := &Sdk{
+		httpClient: &http.Client{},
+	}
+
+	sdk.Client = *client.NewClient(hostname, apiToken, opts...)
+
+	sdk.Api = &ApiInstance{client: &sdk.Client}
+	sdk.Team = &TeamInstance{client: &sdk.Client}
+	sdk.Server = server.NewServer(&sdk.Client)
+	sdk.Database = database.NewDatabaseInstance(&sdk.Client)
+	sdk.S3Storage = storage.NewS3StorageInstance(&sdk.Client)
+	sdk.Destination = destination.NewDestinationInstance(&sdk.Client)
+	sdk.PrivateKey = &PrivateKeyInstance{client: &sdk.Client}
+	sdk.GitHubApp = &GitHubAppInstance{client: &sdk.Client}
+	sdk.Project = &ProjectInstance{client: &sdk.Client}
+
+	return sdk
+}
+
+// Storages returns the volumes and file mounts of the application, service
+// or database identified by uuid.
+func (c *Sdk) Storages(resource storage.Resource, uuid string) StorageAPI {
+	return storage.NewStorageInstance(&c.Client, resource, uuid)
+}
+
+// HeathCheck reports whether the Coolify instance is healthy.
+// Deprecated: Use HealthCheckWithContext instead.
+func (c *Sdk) HeathCheck() (*string, error) {
+	return c.HealthCheckWithContext(context.Background())
+}
+
+// HealthCheckWithContext reports whether the Coolify instance is healthy.
+func (c *Sdk) HealthCheckWithContext(ctx context.Context) (*string, error) {
+	body, err := c.Client.HttpRequestWithContext(ctx, "healthcheck", "GET", bytes.Buffer{})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	bodyBytes, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var healCheckResponse string
+
+	if string(bodyBytes) == "OK" {
+		healCheckResponse = "success"
+	} else {
+		healCheckResponse = "failure"
+	}
+
+	return &healCheckResponse, nil
+}
@@ -1,267 +1,319 @@
-package server
-
-import (
-	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
-	"time"
-
-	"github.com/marconneves/coolify-sdk-go/client"
-)
-
-type ServerInstance struct {
-	client *client.Client
-}
-
-func NewServer(client *client.Client) *ServerInstance {
-	return &ServerInstance{client: client}
-}
-
-type Server struct {
-	IP                            string    `json:"ip"`
-	UUID                          string    `json:"uuid"`
-	Name                          string    `json:"name"`
-	Description                   *string   `json:"description"`
-	HighDiskUsageNotificationSent bool      `json:"high_disk_usage_notification_sent"`
-	LogDrainNotificationSent      bool      `json:"log_drain_notification_sent"`
-	Port                          int       `json:"port"`
-	PrivateKeyID                  int       `json:"private_key_id"`
-	Proxy                         *Proxy    `json:"proxy"`
-	Settings                      *Settings `json:"settings"`
-	SwarmCluster                  *string   `json:"swarm_cluster"`
-	TeamID                        int       `json:"team_id"`
-	UnreachableCount              int       `json:"unreachable_count"`
-	UnreachableNotificationSent   bool      `json:"unreachable_notification_sent"`
-	User                          string    `json:"user"`
-	ValidationLogs                *string   `json:"validation_logs"`
-	CreatedAt                     time.Time `json:"created_at"`
-	UpdatedAt                     time.Time `json:"updated_at"`
-}
-
-type Proxy struct {
-	ForceStop bool   `json:"force_stop"`
-	Status    string `json:"status"`
-	Type      string `json:"type"`
-}
-
-type Settings struct {
-	Id                         int       `json:"id"`
-	ConcurrentBuilds           int       `json:"concurrent_builds"`
-	DeleteUnusedNetworks       bool      `json:"delete_unused_networks"`
-	DeleteUnusedVolumes        bool      `json:"delete_unused_volumes"`
-	DockerCleanupFrequency     string    `json:"docker_cleanup_frequency"`
-	DockerCleanupThreshold     int       `json:"docker_cleanup_threshold"`
-	DynamicTimeout             int       `json:"dynamic_timeout"`
-	ForceDisabled              bool      `json:"force_disabled"`
-	ForceDockerCleanup         bool      `json:"force_docker_cleanup"`
-	GenerateExactLabels        bool      `json:"generate_exact_labels"`
-	IsBuildServer              bool      `json:"is_build_server"`
-	IsCloudflareTunnel         bool      `json:"is_cloudflare_tunnel"`
-	IsJumpServer               bool      `json:"is_jump_server"`
-	IsLogdrainAxiomEnabled     bool      `json:"is_logdrain_axiom_enabled"`
-	IsLogdrainCustomEnabled    bool      `json:"is_logdrain_custom_enabled"`
-	IsLogdrainHighlightEnabled bool      `json:"is_logdrain_highlight_enabled"`
-	IsLogdrainNewRelicEnabled  bool      `json:"is_logdrain_newrelic_enabled"`
-	IsMetricsEnabled           bool      `json:"is_metrics_enabled"`
-	IsReachable                bool      `json:"is_reachable"`
-	IsServerAPIEnabled         bool      `json:"is_server_api_enabled"`
-	IsSwarmManager             bool      `json:"is_swarm_manager"`
-	IsSwarmWorker              bool      `json:"is_swarm_worker"`
-	IsUsable                   bool      `json:"is_usable"`
-	LogdrainAxiomApiKey        *string   `json:"logdrain_axiom_api_key"`
-	LogdrainAxiomDatasetName   *string   `json:"logdrain_axiom_dataset_name"`
-	LogdrainCustomConfig       *string   `json:"logdrain_custom_config"`
-	LogdrainCustomConfigParser *string   `json:"logdrain_custom_config_parser"`
-	LogdrainHighlightProjectId *string   `json:"logdrain_highlight_project_id"`
-	LogdrainNewRelicBaseUri    *string   `json:"logdrain_newrelic_base_uri"`
-	LogdrainNewRelicLicenseKey *string   `json:"logdrain_newrelic_license_key"`
-	MetricsHistoryDays         int       `json:"metrics_history_days"`
-	MetricsRefreshRateSeconds  int       `json:"metrics_refresh_rate_seconds"`
-	MetricsToken               string    `json:"metrics_token"`
-	ServerId                   int       `json:"server_id"`
-	ServerTimezone             string    `json:"server_timezone"`
-	WildcardDomain             *string   `json:"wildcard_domain"`
-	CreatedAt                  time.Time `json:"created_at"`
-	UpdatedAt                  time.Time `json:"updated_at"`
-}
-
-func (t *ServerInstance) DecodeServerResponse(body io.ReadCloser) (*Server, error) {
-	defer body.Close()
-
-	data, err := io.ReadAll(body)
-	if err != nil {
-		return nil, err
-	}
-
-	var raw map[string]interface{}
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return nil, err
-	}
-
-	if proxy, exists := raw["proxy"]; exists {
-		if arr, ok := proxy.([]interface{}); ok && len(arr) == 0 {
-			delete(raw, "proxy")
-		}
-	}
-
-	adjustedData, err := json.Marshal(raw)
-	if err != nil {
-		return nil, err
-	}
-
-	var server Server
-	if err := json.Unmarshal(adjustedData, &server); err != nil {
-		return nil, err
-	}
-
-	return &server, nil
-}
-
-func (t *ServerInstance) List() (*[]Server, error) {
-	body, err := t.client.HttpRequest("servers", "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &[]Server{})
-}
-
-func (t *ServerInstance) Get(uuid string) (*Server, error) {
-	if uuid == "" {
-		return nil, errors.New("uuid is required")
-	}
-
-	body, err := t.client.HttpRequest(fmt.Sprintf("servers/%v", uuid), "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return t.DecodeServerResponse(body)
-}
-
-type CreateServerDTO struct {
-	Name            string `json:"name"`
-	Description     string `json:"description"`
-	IP              string `json:"ip"`
-	Port            int    `json:"port"`
-	User            string `json:"user"`
-	PrivateKeyUUID  string `json:"private_key_uuid"`
-	IsBuildServer   bool   `json:"is_build_server"`
-	InstantValidate bool   `json:"instant_validate"`
-}
-
-type CreateServerResponse struct {
-	UUID string `json:"uuid"`
-}
-
-func (t *ServerInstance) Create(server *CreateServerDTO) (*string, error) {
-	buf, err := client.EncodeRequest(server)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := t.client.HttpRequest("servers", "POST", *buf)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := client.DecodeResponse(body, &CreateServerResponse{})
-	if err != nil {
-		return nil, err
-	}
-
-	return &response.UUID, nil
-}
-
-func (t *ServerInstance) Delete(uuid string) error {
-	if uuid == "" {
-		return errors.New("uuid is required")
-	}
-
-	_, err := t.client.HttpRequest(fmt.Sprintf("servers/%v", uuid), "DELETE")
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-type UpdateServerDTO struct {
-	Name            string `json:"name,omitempty"`
-	Description     string `json:"description,omitempty"`
-	IP              string `json:"ip,omitempty"`
-	Port            int    `json:"port,omitempty"`
-	User            string `json:"user,omitempty"`
-	PrivateKeyUUID  string `json:"private_key_uuid,omitempty"`
-	IsBuildServer   bool   `json:"is_build_server,omitempty"`
-	InstantValidate bool   `json:"instant_validate,omitempty"`
-}
-
-func (t *ServerInstance) Update(uuid string, server *UpdateServerDTO) error {
-	if uuid == "" {
-		return errors.New("uuid is required")
-	}
-
-	buf, err := client.EncodeRequest(server)
-	if err != nil {
-		return err
-	}
-
-	_, err = t.client.HttpRequest(fmt.Sprintf("servers/%v", uuid), "PATCH", *buf)
-	return err
-}
-
-type Resource struct {
-	Id        int       `json:"id"`
-	UUID      string    `json:"uuid"`
-	Name      string    `json:"name"`
-	Type      string    `json:"type"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-	Status    string    `json:"status"`
-}
-
-func (t *ServerInstance) Resources(uuid string) (*[]Resource, error) {
-	if uuid == "" {
-		return nil, errors.New("uuid is required")
-	}
-
-	body, err := t.client.HttpRequest(fmt.Sprintf("servers/%v/resources", uuid), "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &[]Resource{})
-}
-
-type Domain struct {
-	Id      int      `json:"id"`
-	Domains []string `json:"domains"`
-}
-
-func (t *ServerInstance) Domains(uuid string) (*[]Domain, error) {
-	if uuid == "" {
-		return nil, errors.New("uuid is required")
-	}
-
-	body, err := t.client.HttpRequest(fmt.Sprintf("servers/%v/domains", uuid), "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &[]Domain{})
-}
-
-func (t *ServerInstance) Validate(uuid string) error {
-	if uuid == "" {
-		return errors.New("uuid is required")
-	}
-
-	_, err := t.client.HttpRequest(fmt.Sprintf("servers/%v/validate", uuid), "GET")
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+type ServerInstance struct {
+	client *client.Client
+}
+
+func NewServer(client *client.Client) *ServerInstance {
+	return &ServerInstance{client: client}
+}
+
+type Server struct {
+	IP                            string           `json:"ip"`
+	UUID                          string           `json:"uuid"`
+	Name                          string           `json:"name"`
+	Description                   *string          `json:"description"`
+	HighDiskUsageNotificationSent bool             `json:"high_disk_usage_notification_sent"`
+	LogDrainNotificationSent      bool             `json:"log_drain_notification_sent"`
+	Port                          int              `json:"port"`
+	PrivateKeyID                  int              `json:"private_key_id"`
+	Proxy                         *Proxy           `json:"proxy"`
+	Settings                      *Settings        `json:"settings"`
+	SwarmCluster                  *string          `json:"swarm_cluster"`
+	TeamID                        int              `json:"team_id"`
+	UnreachableCount              int              `json:"unreachable_count"`
+	UnreachableNotificationSent   bool             `json:"unreachable_notification_sent"`
+	User                          string           `json:"user"`
+	ValidationLogs                *string          `json:"validation_logs"`
+	CreatedAt                     client.Timestamp `json:"created_at"`
+	UpdatedAt                     client.Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type Proxy struct {
+	ForceStop bool   `json:"force_stop"`
+	Status    string `json:"status"`
+	Type      string `json:"type"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type Settings struct {
+	Id                         int              `json:"id"`
+	ConcurrentBuilds           int              `json:"concurrent_builds"`
+	DeleteUnusedNetworks       bool             `json:"delete_unused_networks"`
+	DeleteUnusedVolumes        bool             `json:"delete_unused_volumes"`
+	DockerCleanupFrequency     string           `json:"docker_cleanup_frequency"`
+	DockerCleanupThreshold     int              `json:"docker_cleanup_threshold"`
+	DynamicTimeout             int              `json:"dynamic_timeout"`
+	ForceDisabled              bool             `json:"force_disabled"`
+	ForceDockerCleanup         bool             `json:"force_docker_cleanup"`
+	GenerateExactLabels        bool             `json:"generate_exact_labels"`
+	IsBuildServer              bool             `json:"is_build_server"`
+	IsCloudflareTunnel         bool             `json:"is_cloudflare_tunnel"`
+	IsJumpServer               bool             `json:"is_jump_server"`
+	IsLogdrainAxiomEnabled     bool             `json:"is_logdrain_axiom_enabled"`
+	IsLogdrainCustomEnabled    bool             `json:"is_logdrain_custom_enabled"`
+	IsLogdrainHighlightEnabled bool             `json:"is_logdrain_highlight_enabled"`
+	IsLogdrainNewRelicEnabled  bool             `json:"is_logdrain_newrelic_enabled"`
+	IsMetricsEnabled           bool             `json:"is_metrics_enabled"`
+	IsReachable                bool             `json:"is_reachable"`
+	IsServerAPIEnabled         bool             `json:"is_server_api_enabled"`
+	IsSwarmManager             bool             `json:"is_swarm_manager"`
+	IsSwarmWorker              bool             `json:"is_swarm_worker"`
+	IsUsable                   bool             `json:"is_usable"`
+	LogdrainAxiomApiKey        *string          `json:"logdrain_axiom_api_key"`
+	LogdrainAxiomDatasetName   *string          `json:"logdrain_axiom_dataset_name"`
+	LogdrainCustomConfig       *string          `json:"logdrain_custom_config"`
+	LogdrainCustomConfigParser *string          `json:"logdrain_custom_config_parser"`
+	LogdrainHighlightProjectId *string          `json:"logdrain_highlight_project_id"`
+	LogdrainNewRelicBaseUri    *string          `json:"logdrain_newrelic_base_uri"`
+	LogdrainNewRelicLicenseKey *string          `json:"logdrain_newrelic_license_key"`
+	MetricsHistoryDays         int              `json:"metrics_history_days"`
+	MetricsRefreshRateSeconds  int              `json:"metrics_refresh_rate_seconds"`
+	MetricsToken               string           `json:"metrics_token"`
+	ServerId                   int              `json:"server_id"`
+	ServerTimezone             string           `json:"server_timezone"`
+	WildcardDomain             *string          `json:"wildcard_domain"`
+	CreatedAt                  client.Timestamp `json:"created_at"`
+	UpdatedAt                  client.Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// DecodeServerResponse decodes a server payload.
+// Deprecated: client.DecodeResponse handles Laravel's empty proxy array.
+func (t *ServerInstance) DecodeServerResponse(body io.ReadCloser) (*Server, error) {
+	return client.DecodeResponse(body, &Server{})
+}
+
+// List retrieves all servers.
+// Deprecated: Use ListWithContext instead.
+func (t *ServerInstance) List() (*[]Server, error) {
+	return t.ListWithContext(context.Background())
+}
+
+// ListWithContext retrieves all servers.
+func (t *ServerInstance) ListWithContext(ctx context.Context) (*[]Server, error) {
+	body, err := t.client.HttpRequestWithContext(ctx, "servers", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &[]Server{})
+}
+
+// All iterates over all servers.
+func (t *ServerInstance) All(ctx context.Context) iter.Seq2[Server, error] {
+	return client.Iterate[Server](ctx, t.client, "servers", nil)
+}
+
+// Get retrieves a server by UUID.
+// Deprecated: Use GetWithContext instead.
+func (t *ServerInstance) Get(uuid string) (*Server, error) {
+	return t.GetWithContext(context.Background(), uuid)
+}
+
+// GetWithContext retrieves a server by UUID.
+func (t *ServerInstance) GetWithContext(ctx context.Context, uuid string) (*Server, error) {
+	if uuid == "" {
+		return nil, errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("servers/%v", uuid), "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &Server{})
+}
+
+type CreateServerDTO struct {
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	IP              string `json:"ip"`
+	Port            int    `json:"port"`
+	User            string `json:"user"`
+	PrivateKeyUUID  string `json:"private_key_uuid"`
+	IsBuildServer   bool   `json:"is_build_server"`
+	InstantValidate bool   `json:"instant_validate"`
+}
+
+type CreateServerResponse struct {
+	UUID string `json:"uuid"`
+}
+
+// Create creates a server and returns its UUID.
+// Deprecated: Use CreateWithContext instead.
+func (t *ServerInstance) Create(server *CreateServerDTO) (*string, error) {
+	return t.CreateWithContext(context.Background(), server)
+}
+
+// CreateWithContext creates a server and returns its UUID.
+func (t *ServerInstance) CreateWithContext(ctx context.Context, server *CreateServerDTO) (*string, error) {
+	buf, err := client.EncodeRequest(server)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, "servers", "POST", *buf)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.DecodeResponse(body, &CreateServerResponse{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.UUID, nil
+}
+
+// Delete removes a server.
+// Deprecated: Use DeleteWithContext instead.
+func (t *ServerInstance) Delete(uuid string) error {
+	return t.DeleteWithContext(context.Background(), uuid)
+}
+
+// DeleteWithContext removes a server.
+func (t *ServerInstance) DeleteWithContext(ctx context.Context, uuid string) error {
+	if uuid == "" {
+		return errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("servers/%v", uuid), "DELETE")
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+type UpdateServerDTO struct {
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	IP              string `json:"ip,omitempty"`
+	Port            int    `json:"port,omitempty"`
+	User            string `json:"user,omitempty"`
+	PrivateKeyUUID  string `json:"private_key_uuid,omitempty"`
+	IsBuildServer   bool   `json:"is_build_server,omitempty"`
+	InstantValidate bool   `json:"instant_validate,omitempty"`
+}
+
+// Update updates a server.
+// Deprecated: Use UpdateWithContext instead.
+func (t *ServerInstance) Update(uuid string, server *UpdateServerDTO) error {
+	return t.UpdateWithContext(context.Background(), uuid, server)
+}
+
+// UpdateWithContext updates a server.
+func (t *ServerInstance) UpdateWithContext(ctx context.Context, uuid string, server *UpdateServerDTO) error {
+	if uuid == "" {
+		return errors.New("uuid is required")
+	}
+
+	buf, err := client.EncodeRequest(server)
+	if err != nil {
+		return err
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("servers/%v", uuid), "PATCH", *buf)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
+type Resource struct {
+	Id        int              `json:"id"`
+	UUID      string           `json:"uuid"`
+	Name      string           `json:"name"`
+	Type      string           `json:"type"`
+	CreatedAt client.Timestamp `json:"created_at"`
+	UpdatedAt client.Timestamp `json:"updated_at"`
+	Status    string           `json:"status"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Resources lists the resources deployed on a server.
+// Deprecated: Use ResourcesWithContext instead.
+func (t *ServerInstance) Resources(uuid string) (*[]Resource, error) {
+	return t.ResourcesWithContext(context.Background(), uuid)
+}
+
+// ResourcesWithContext lists the resources deployed on a server.
+func (t *ServerInstance) ResourcesWithContext(ctx context.Context, uuid string) (*[]Resource, error) {
+	if uuid == "" {
+		return nil, errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("servers/%v/resources", uuid), "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &[]Resource{})
+}
+
+type Domain struct {
+	Id      int      `json:"id"`
+	Domains []string `json:"domains"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Domains lists the domains served by a server.
+// Deprecated: Use DomainsWithContext instead.
+func (t *ServerInstance) Domains(uuid string) (*[]Domain, error) {
+	return t.DomainsWithContext(context.Background(), uuid)
+}
+
+// DomainsWithContext lists the domains served by a server.
+func (t *ServerInstance) DomainsWithContext(ctx context.Context, uuid string) (*[]Domain, error) {
+	if uuid == "" {
+		return nil, errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("servers/%v/domains", uuid), "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &[]Domain{})
+}
+
+// Validate triggers the validation of a server.
+// Deprecated: Use ValidateWithContext instead.
+func (t *ServerInstance) Validate(uuid string) error {
+	return t.ValidateWithContext(context.Background(), uuid)
+}
+
+// ValidateWithContext triggers the validation of a server.
+func (t *ServerInstance) ValidateWithContext(ctx context.Context, uuid string) error {
+	if uuid == "" {
+		return errors.New("uuid is required")
+	}
+
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("servers/%v/validate", uuid), "GET")
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
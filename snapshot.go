@@ -0,0 +1,428 @@
+package coolify_sdk
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+	database "github.com/marconneves/coolify-sdk-go/database"
+	server "github.com/marconneves/coolify-sdk-go/server"
+)
+
+// SnapshotVersion is the snapshot format written by Export.
+const SnapshotVersion = 1
+
+// Snapshot is a point-in-time dump of a Coolify instance. Every list is
+// sorted so that two exports of the same state differ only in ExportedAt.
+type Snapshot struct {
+	Version     int              `json:"version"`
+	ExportedAt  time.Time        `json:"exported_at"`
+	Teams       []SnapshotTeam   `json:"teams"`
+	Projects    []Project        `json:"projects"`
+	Servers     []SnapshotServer `json:"servers"`
+	PrivateKeys []PrivateKey     `json:"private_keys"`
+	Databases   []Database       `json:"databases"`
+}
+
+// SnapshotTeam is a team with its members.
+type SnapshotTeam struct {
+	Team
+	Members []Member `json:"members"`
+}
+
+// SnapshotServer is a server with its settings and deployed resources.
+type SnapshotServer struct {
+	Server
+	Resources []Resource `json:"resources"`
+}
+
+// ExportOptions controls what Export includes.
+type ExportOptions struct {
+	// IncludeSecrets keeps private key material, database passwords and
+	// server metrics tokens. They are blanked by default.
+	IncludeSecrets bool
+}
+
+// Export walks teams, projects, servers, private keys and databases and
+// returns them as a Snapshot.
+func (c *Sdk) Export(ctx context.Context, opts ExportOptions) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Version:    SnapshotVersion,
+		ExportedAt: time.Now().UTC().Truncate(time.Second),
+	}
+
+	teams, err := c.Team.ListWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	for _, team := range *teams {
+		members, err := c.Team.MembersWithContext(ctx, team.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list members of team %d: %w", team.Id, err)
+		}
+		slices.SortFunc(*members, func(a, b Member) int { return cmp.Compare(a.Id, b.Id) })
+		snapshot.Teams = append(snapshot.Teams, SnapshotTeam{Team: team, Members: *members})
+	}
+	slices.SortFunc(snapshot.Teams, func(a, b SnapshotTeam) int { return cmp.Compare(a.Id, b.Id) })
+
+	projects, err := c.Project.ListWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	for _, listed := range *projects {
+		project, err := c.Project.GetWithContext(ctx, listed.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project %s: %w", listed.UUID, err)
+		}
+		slices.SortFunc(project.Environments, func(a, b Environment) int { return cmp.Compare(a.ID, b.ID) })
+		snapshot.Projects = append(snapshot.Projects, *project)
+	}
+	slices.SortFunc(snapshot.Projects, func(a, b Project) int { return byName(a.Name, a.UUID, b.Name, b.UUID) })
+
+	servers, err := c.Server.ListWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list servers: %w", err)
+	}
+	for _, listed := range *servers {
+		srv, err := c.Server.GetWithContext(ctx, listed.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get server %s: %w", listed.UUID, err)
+		}
+		resources, err := c.Server.ResourcesWithContext(ctx, listed.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resources of server %s: %w", listed.UUID, err)
+		}
+		slices.SortFunc(*resources, func(a, b Resource) int { return strings.Compare(a.UUID, b.UUID) })
+		if !opts.IncludeSecrets {
+			redactServerSettings(srv.Settings)
+		}
+		snapshot.Servers = append(snapshot.Servers, SnapshotServer{Server: *srv, Resources: *resources})
+	}
+	slices.SortFunc(snapshot.Servers, func(a, b SnapshotServer) int { return byName(a.Name, a.UUID, b.Name, b.UUID) })
+
+	keys, err := c.PrivateKey.ListWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list private keys: %w", err)
+	}
+	for _, key := range *keys {
+		if !opts.IncludeSecrets {
+			key.PrivateKey = ""
+		}
+		snapshot.PrivateKeys = append(snapshot.PrivateKeys, key)
+	}
+	slices.SortFunc(snapshot.PrivateKeys, func(a, b PrivateKey) int { return byName(a.Name, a.UUID, b.Name, b.UUID) })
+
+	databases, err := c.Database.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range *databases {
+		if !opts.IncludeSecrets {
+			redactDatabase(&db)
+		}
+		snapshot.Databases = append(snapshot.Databases, db)
+	}
+	slices.SortFunc(snapshot.Databases, func(a, b Database) int { return byName(a.Name, a.UUID, b.Name, b.UUID) })
+
+	return snapshot, nil
+}
+
+// Write encodes the snapshot as indented JSON.
+func (s *Snapshot) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// ReadSnapshot decodes a snapshot written by Snapshot.Write.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := json.NewDecoder(r).Decode(snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+	return snapshot, nil
+}
+
+// ImportOptions controls how Import recreates a snapshot.
+type ImportOptions struct {
+	// PrivateKeys supplies key material by exported UUID, for snapshots
+	// exported without secrets.
+	PrivateKeys map[string]string
+}
+
+// ImportResult describes what Import did.
+type ImportResult struct {
+	// UUIDs maps exported UUIDs to the UUIDs on the target instance.
+	UUIDs map[string]string
+	// Skipped lists what could not be recreated through the API and why.
+	Skipped []string
+}
+
+// Import recreates the snapshot's private keys, servers, projects and
+// databases on the SDK's instance, remapping references to the new UUIDs.
+// Servers whose IP already exists on the target are reused instead of
+// created, and only the private keys of created servers are imported.
+// Teams, members and environments other than "production" cannot be created
+// through the API and are reported in ImportResult.Skipped.
+func (c *Sdk) Import(ctx context.Context, snapshot *Snapshot, opts ImportOptions) (*ImportResult, error) {
+	if snapshot.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+
+	existing, err := c.Server.ListWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list servers: %w", err)
+	}
+	serversByIP := map[string]string{}
+	for _, srv := range *existing {
+		serversByIP[srv.IP] = srv.UUID
+	}
+
+	// Only the keys of servers that will be created are needed.
+	keyIDs := map[int]bool{}
+	for _, key := range snapshot.PrivateKeys {
+		keyIDs[key.ID] = true
+	}
+	neededKeys := map[int]bool{}
+	for _, srv := range snapshot.Servers {
+		if _, ok := serversByIP[srv.IP]; ok {
+			continue
+		}
+		if !keyIDs[srv.PrivateKeyID] {
+			return nil, fmt.Errorf("server %q uses private key %d, which is not in the snapshot", srv.Name, srv.PrivateKeyID)
+		}
+		neededKeys[srv.PrivateKeyID] = true
+	}
+
+	keyMaterial := map[string]string{}
+	keyUUIDs := map[int]string{}
+	for _, key := range snapshot.PrivateKeys {
+		if !neededKeys[key.ID] {
+			continue
+		}
+		material := cmp.Or(key.PrivateKey, opts.PrivateKeys[key.UUID])
+		if material == "" {
+			return nil, fmt.Errorf("private key %q has no material, export with IncludeSecrets or set ImportOptions.PrivateKeys", key.Name)
+		}
+		keyMaterial[key.UUID] = material
+		keyUUIDs[key.ID] = key.UUID
+	}
+
+	result := &ImportResult{UUIDs: map[string]string{}}
+	for _, team := range snapshot.Teams {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("team %q: teams cannot be created through the API", team.Name))
+	}
+
+	for _, key := range snapshot.PrivateKeys {
+		if !neededKeys[key.ID] {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("private key %q: no imported server uses it", key.Name))
+			continue
+		}
+		uuid, err := c.PrivateKey.CreateWithContext(ctx, &CreatePrivateKeyDTO{
+			Name:        key.Name,
+			Description: &key.Description,
+			PrivateKey:  keyMaterial[key.UUID],
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to import private key %q: %w", key.Name, err)
+		}
+		result.UUIDs[key.UUID] = *uuid
+	}
+
+	for _, srv := range snapshot.Servers {
+		if uuid, ok := serversByIP[srv.IP]; ok {
+			result.UUIDs[srv.UUID] = uuid
+			continue
+		}
+
+		uuid, err := c.Server.CreateWithContext(ctx, &server.CreateServerDTO{
+			Name:           srv.Name,
+			Description:    client.Deref(srv.Description),
+			IP:             srv.IP,
+			Port:           srv.Port,
+			User:           srv.User,
+			PrivateKeyUUID: result.UUIDs[keyUUIDs[srv.PrivateKeyID]],
+			IsBuildServer:  srv.Settings != nil && srv.Settings.IsBuildServer,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to import server %q: %w", srv.Name, err)
+		}
+		result.UUIDs[srv.UUID] = *uuid
+	}
+
+	type target struct {
+		project     string
+		environment string
+	}
+	environments := map[int]target{}
+	for _, project := range snapshot.Projects {
+		uuid, err := c.Project.CreateWithContext(ctx, &CreateProjectDTO{
+			Name:        &project.Name,
+			Description: project.Description,
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to import project %q: %w", project.Name, err)
+		}
+		result.UUIDs[project.UUID] = *uuid
+
+		for _, environment := range project.Environments {
+			if environment.Name != "production" {
+				result.Skipped = append(result.Skipped, fmt.Sprintf("environment %q of project %q: environments cannot be created through the API", environment.Name, project.Name))
+				continue
+			}
+			environments[int(environment.ID)] = target{project: *uuid, environment: environment.Name}
+		}
+	}
+
+	for _, db := range snapshot.Databases {
+		env, ok := environments[db.EnvironmentID]
+		if !ok {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("database %q: its environment was not imported", db.Name))
+			continue
+		}
+		serverUUID, ok := result.UUIDs[db.Destination.Server.UUID]
+		if !ok {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("database %q: its server is not in the snapshot", db.Name))
+			continue
+		}
+
+		uuid, err := c.importDatabase(ctx, db, serverUUID, env.project, env.environment)
+		if errors.Is(err, errUnsupportedDatabase) {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("database %q: %v", db.Name, err))
+			continue
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to import database %q: %w", db.Name, err)
+		}
+		result.UUIDs[db.UUID] = *uuid
+	}
+
+	return result, nil
+}
+
+var errUnsupportedDatabase = errors.New("database type cannot be created by the SDK")
+
+func (c *Sdk) importDatabase(ctx context.Context, db Database, serverUUID, projectUUID, environment string) (*string, error) {
+	switch db.DatabaseType {
+	case "standalone-postgresql":
+		return c.Database.CreatePostgreSQL(ctx, &database.CreateDatabasePostgresDTO{
+			ServerUUID:             serverUUID,
+			ProjectUUID:            projectUUID,
+			Environment:            environment,
+			Name:                   &db.Name,
+			Description:            db.Description,
+			Image:                  &db.Image,
+			IsPublic:               &db.IsPublic,
+			PublicPort:             client.Optional(db.PublicPort),
+			PostgresUser:           client.Optional(db.PostgresUser),
+			PostgresPassword:       client.Optional(db.PostgresPassword),
+			PostgresDB:             client.Optional(db.PostgresDB),
+			PostgresInitdbArgs:     db.PostgresInitdbArgs,
+			PostgresHostAuthMethod: db.PostgresHostAuthMethod,
+			PostgresConf:           db.PostgresConf,
+			LimitsMemory:           client.Optional(db.LimitsMemory),
+			LimitsCPUs:             client.Optional(db.LimitsCpus),
+		})
+	case "standalone-mysql":
+		return c.Database.CreateMySQL(ctx, &database.CreateDatabaseMySQLDTO{
+			ServerUUID:        serverUUID,
+			ProjectUUID:       projectUUID,
+			EnvironmentName:   environment,
+			Name:              &db.Name,
+			Description:       db.Description,
+			Image:             &db.Image,
+			IsPublic:          &db.IsPublic,
+			PublicPort:        client.Optional(db.PublicPort),
+			MysqlRootPassword: db.MysqlRootPassword,
+			MysqlPassword:     db.MysqlPassword,
+			MysqlUser:         db.MysqlUser,
+			MysqlDatabase:     db.MysqlDatabase,
+			MysqlConf:         db.MysqlConf,
+			LimitsMemory:      client.Optional(db.LimitsMemory),
+			LimitsCPUs:        client.Optional(db.LimitsCpus),
+		})
+	case "standalone-mariadb":
+		return c.Database.CreateMariaDB(ctx, &database.CreateDatabaseMariaDBDTO{
+			ServerUUID:          serverUUID,
+			ProjectUUID:         projectUUID,
+			EnvironmentName:     environment,
+			Name:                &db.Name,
+			Description:         db.Description,
+			Image:               &db.Image,
+			IsPublic:            &db.IsPublic,
+			PublicPort:          client.Optional(db.PublicPort),
+			MariadbConf:         db.MariadbConf,
+			MariadbRootPassword: db.MariadbRootPassword,
+			MariadbUser:         db.MariadbUser,
+			MariadbPassword:     db.MariadbPassword,
+			MariadbDatabase:     db.MariadbDatabase,
+			LimitsMemory:        client.Optional(db.LimitsMemory),
+			LimitsCPUs:          client.Optional(db.LimitsCpus),
+		})
+	case "standalone-redis":
+		return c.Database.CreateRedis(ctx, &database.CreateDatabaseRedisDTO{
+			ServerUUID:      serverUUID,
+			ProjectUUID:     projectUUID,
+			EnvironmentName: environment,
+			Name:            &db.Name,
+			Description:     db.Description,
+			Image:           &db.Image,
+			IsPublic:        &db.IsPublic,
+			PublicPort:      client.Optional(db.PublicPort),
+			RedisPassword:   db.RedisPassword,
+			RedisConf:       db.RedisConf,
+			LimitsMemory:    client.Optional(db.LimitsMemory),
+			LimitsCPUs:      client.Optional(db.LimitsCpus),
+		})
+	}
+
+	return nil, fmt.Errorf("%s: %w", db.DatabaseType, errUnsupportedDatabase)
+}
+
+// redactDatabase blanks the credentials of a database.
+func redactDatabase(db *Database) {
+	db.PostgresPassword = ""
+	for _, secret := range []**string{
+		&db.DragonflyPassword,
+		&db.KeydbPassword,
+		&db.ClickhouseAdminPassword,
+		&db.MariadbPassword,
+		&db.MariadbRootPassword,
+		&db.MongoInitdbRootPassword,
+		&db.MysqlPassword,
+		&db.MysqlRootPassword,
+		&db.RedisPassword,
+	} {
+		*secret = nil
+	}
+
+	// The URLs embed the password.
+	db.ExternalDbURL = ""
+	db.InternalDbURL = ""
+
+	redactServerSettings(db.Destination.Server.Settings)
+}
+
+// redactServerSettings blanks the tokens and API keys of server settings.
+func redactServerSettings(settings *server.Settings) {
+	if settings == nil {
+		return
+	}
+	settings.MetricsToken = ""
+	settings.LogdrainAxiomApiKey = nil
+	settings.LogdrainNewRelicLicenseKey = nil
+}
+
+func byName(aName, aUUID, bName, bUUID string) int {
+	return cmp.Or(strings.Compare(aName, bName), strings.Compare(aUUID, bUUID))
+}
@@ -0,0 +1,325 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// Redacted replaces S3 credentials when a storage or its DTOs are printed or
+// logged.
+const Redacted = "REDACTED"
+
+// S3StorageInstance provides methods to manage the S3 storages backups are
+// uploaded to.
+type S3StorageInstance struct {
+	client *client.Client
+}
+
+// NewS3StorageInstance creates a new S3StorageInstance.
+func NewS3StorageInstance(client *client.Client) *S3StorageInstance {
+	return &S3StorageInstance{client: client}
+}
+
+// S3Storage is an S3 compatible bucket registered in Coolify. Key and Secret
+// are redacted when the storage is formatted with fmt or logged with slog.
+type S3Storage struct {
+	ID          int              `json:"id"`
+	UUID        string           `json:"uuid"`
+	Name        string           `json:"name"`
+	Description *string          `json:"description"`
+	Endpoint    string           `json:"endpoint"`
+	Bucket      string           `json:"bucket"`
+	Region      string           `json:"region"`
+	Key         string           `json:"key"`
+	Secret      string           `json:"secret"`
+	IsUsable    bool             `json:"is_usable"`
+	TeamID      int              `json:"team_id"`
+	CreatedAt   client.Timestamp `json:"created_at"`
+	UpdatedAt   client.Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// redacted returns a copy of s without credentials.
+func (s S3Storage) redacted() s3Storage {
+	if s.Key != "" {
+		s.Key = Redacted
+	}
+	if s.Secret != "" {
+		s.Secret = Redacted
+	}
+	return s3Storage(s)
+}
+
+// s3Storage has the fields of S3Storage without its methods, so formatting
+// it does not recurse.
+type s3Storage S3Storage
+
+// String formats s with its credentials redacted.
+func (s S3Storage) String() string {
+	return fmt.Sprintf("%+v", s.redacted())
+}
+
+// GoString formats s for %#v with its credentials redacted.
+func (s S3Storage) GoString() string {
+	return fmt.Sprintf("%#v", s.redacted())
+}
+
+// LogValue implements slog.LogValuer.
+func (s S3Storage) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("uuid", s.UUID),
+		slog.String("name", s.Name),
+		slog.String("endpoint", s.Endpoint),
+		slog.String("bucket", s.Bucket),
+		slog.String("region", s.Region),
+		slog.String("key", s.redacted().Key),
+		slog.String("secret", s.redacted().Secret),
+	)
+}
+
+// CreateS3StorageDTO represents the data required to register an S3 storage.
+// Key and Secret are redacted when it is formatted or logged.
+type CreateS3StorageDTO struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Endpoint    string  `json:"endpoint"`
+	Bucket      string  `json:"bucket"`
+	Region      string  `json:"region"`
+	Key         string  `json:"key"`
+	Secret      string  `json:"secret"`
+}
+
+// redacted returns a copy of d without credentials.
+func (d CreateS3StorageDTO) redacted() createS3StorageDTO {
+	if d.Key != "" {
+		d.Key = Redacted
+	}
+	if d.Secret != "" {
+		d.Secret = Redacted
+	}
+	return createS3StorageDTO(d)
+}
+
+// createS3StorageDTO has the fields of CreateS3StorageDTO without its
+// methods, so formatting it does not recurse.
+type createS3StorageDTO CreateS3StorageDTO
+
+// String formats d with its credentials redacted.
+func (d CreateS3StorageDTO) String() string {
+	return fmt.Sprintf("%+v", d.redacted())
+}
+
+// GoString formats d for %#v with its credentials redacted.
+func (d CreateS3StorageDTO) GoString() string {
+	return fmt.Sprintf("%#v", d.redacted())
+}
+
+// LogValue implements slog.LogValuer.
+func (d CreateS3StorageDTO) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", d.Name),
+		slog.String("endpoint", d.Endpoint),
+		slog.String("bucket", d.Bucket),
+		slog.String("region", d.Region),
+		slog.String("key", d.redacted().Key),
+		slog.String("secret", d.redacted().Secret),
+	)
+}
+
+// CreateS3StorageResponse represents the response when creating an S3
+// storage.
+type CreateS3StorageResponse struct {
+	UUID string `json:"uuid"`
+}
+
+// UpdateS3StorageDTO represents the data required to update an S3 storage.
+// Key and Secret are redacted when it is formatted or logged.
+type UpdateS3StorageDTO struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Endpoint    *string `json:"endpoint,omitempty"`
+	Bucket      *string `json:"bucket,omitempty"`
+	Region      *string `json:"region,omitempty"`
+	Key         *string `json:"key,omitempty"`
+	Secret      *string `json:"secret,omitempty"`
+}
+
+// attrs returns the fields set in d, with its credentials redacted.
+func (d UpdateS3StorageDTO) attrs() []slog.Attr {
+	var attrs []slog.Attr
+	for _, field := range []struct {
+		name   string
+		value  *string
+		secret bool
+	}{
+		{"name", d.Name, false},
+		{"description", d.Description, false},
+		{"endpoint", d.Endpoint, false},
+		{"bucket", d.Bucket, false},
+		{"region", d.Region, false},
+		{"key", d.Key, true},
+		{"secret", d.Secret, true},
+	} {
+		if field.value == nil {
+			continue
+		}
+		value := *field.value
+		if field.secret && value != "" {
+			value = Redacted
+		}
+		attrs = append(attrs, slog.String(field.name, value))
+	}
+	return attrs
+}
+
+// String formats the fields set in d with its credentials redacted. The
+// pointers themselves are not printed.
+func (d UpdateS3StorageDTO) String() string {
+	fields := make([]string, 0, 7)
+	for _, attr := range d.attrs() {
+		fields = append(fields, attr.Key+":"+attr.Value.String())
+	}
+	return "{" + strings.Join(fields, " ") + "}"
+}
+
+// GoString formats d for %#v with its credentials redacted.
+func (d UpdateS3StorageDTO) GoString() string {
+	return "storage.UpdateS3StorageDTO" + d.String()
+}
+
+// LogValue implements slog.LogValuer.
+func (d UpdateS3StorageDTO) LogValue() slog.Value {
+	return slog.GroupValue(d.attrs()...)
+}
+
+// List retrieves all S3 storages of the team.
+func (s *S3StorageInstance) List(ctx context.Context) (*[]S3Storage, error) {
+	body, err := s.client.HttpRequestWithContext(ctx, "storages", "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list S3 storages: %w", err)
+	}
+
+	res, err := client.DecodeResponse(body, &[]S3Storage{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return res, nil
+}
+
+// Get retrieves an S3 storage by UUID.
+func (s *S3StorageInstance) Get(ctx context.Context, uuid string) (*S3Storage, error) {
+	if uuid == "" {
+		return nil, errors.New("UUID is required")
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, fmt.Sprintf("storages/%v", uuid), "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get S3 storage %s: %w", uuid, err)
+	}
+
+	res, err := client.DecodeResponse(body, &S3Storage{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode S3 storage %s: %w", uuid, err)
+	}
+
+	return res, nil
+}
+
+// Create registers an S3 storage and returns its UUID.
+func (s *S3StorageInstance) Create(ctx context.Context, data *CreateS3StorageDTO) (*string, error) {
+	if err := data.validate(); err != nil {
+		return nil, err
+	}
+
+	buf, err := client.EncodeRequest(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, "storages", "POST", *buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 storage: %w", err)
+	}
+
+	response, err := client.DecodeResponse(body, &CreateS3StorageResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response.UUID, nil
+}
+
+// Update updates an S3 storage.
+func (s *S3StorageInstance) Update(ctx context.Context, uuid string, data *UpdateS3StorageDTO) error {
+	if uuid == "" {
+		return errors.New("UUID is required")
+	}
+
+	buf, err := client.EncodeRequest(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, fmt.Sprintf("storages/%v", uuid), "PATCH", *buf)
+	if err != nil {
+		return fmt.Errorf("failed to update S3 storage %s: %w", uuid, err)
+	}
+	body.Close()
+
+	return nil
+}
+
+// Delete removes an S3 storage.
+func (s *S3StorageInstance) Delete(ctx context.Context, uuid string) error {
+	if uuid == "" {
+		return errors.New("UUID is required")
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, fmt.Sprintf("storages/%v", uuid), "DELETE")
+	if err != nil {
+		return fmt.Errorf("failed to delete S3 storage %s: %w", uuid, err)
+	}
+	body.Close()
+
+	return nil
+}
+
+// Test asks Coolify to connect to the bucket with the stored credentials.
+// It returns an error when the bucket cannot be reached.
+func (s *S3StorageInstance) Test(ctx context.Context, uuid string) error {
+	if uuid == "" {
+		return errors.New("UUID is required")
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, fmt.Sprintf("storages/%v/test", uuid), "GET")
+	if err != nil {
+		return fmt.Errorf("S3 storage %s is not reachable: %w", uuid, err)
+	}
+	body.Close()
+
+	return nil
+}
+
+func (d *CreateS3StorageDTO) validate() error {
+	switch {
+	case d.Name == "":
+		return errors.New("name is required")
+	case d.Endpoint == "":
+		return errors.New("endpoint is required")
+	case d.Bucket == "":
+		return errors.New("bucket is required")
+	case d.Region == "":
+		return errors.New("region is required")
+	case d.Key == "" || d.Secret == "":
+		return errors.New("key and secret are required")
+	}
+	return nil
+}
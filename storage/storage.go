@@ -0,0 +1,223 @@
+// Package storage manages the persistent volumes and file mounts of
+// applications, services and databases, and the S3 storages used for
+// backups.
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+// Resource is the kind of resource storages belong to.
+type Resource string
+
+const (
+	Applications Resource = "applications"
+	Services     Resource = "services"
+	Databases    Resource = "databases"
+)
+
+// Type distinguishes persistent volumes from file mounts.
+type Type string
+
+const (
+	Persistent Type = "persistent"
+	File       Type = "file"
+)
+
+// StorageAPI is implemented by StorageInstance. It is what
+// database.DatabaseInstance.Storages returns, so that callers can substitute
+// a mock.
+type StorageAPI interface {
+	List(ctx context.Context) (*[]Storage, error)
+	Create(ctx context.Context, data *CreateStorageDTO) (*string, error)
+	Update(ctx context.Context, storageUUID string, data *UpdateStorageDTO) error
+	Delete(ctx context.Context, storageUUID string) error
+}
+
+var _ StorageAPI = (*StorageInstance)(nil)
+
+// StorageInstance provides methods to manage the storages of one resource.
+type StorageInstance struct {
+	client   *client.Client
+	resource Resource
+	uuid     string
+}
+
+// NewStorageInstance returns the storages of the resource identified by
+// uuid.
+func NewStorageInstance(client *client.Client, resource Resource, uuid string) *StorageInstance {
+	return &StorageInstance{client: client, resource: resource, uuid: uuid}
+}
+
+// Storage is a persistent volume or a file mount. Name and HostPath are
+// only set on volumes; FsPath, Content and IsDirectory only on file mounts.
+type Storage struct {
+	ID           int              `json:"id"`
+	UUID         string           `json:"uuid"`
+	Type         Type             `json:"type"`
+	Name         string           `json:"name"`
+	MountPath    string           `json:"mount_path"`
+	HostPath     *string          `json:"host_path"`
+	FsPath       string           `json:"fs_path"`
+	Content      *string          `json:"content"`
+	IsDirectory  bool             `json:"is_directory"`
+	ResourceID   int              `json:"resource_id"`
+	ResourceType string           `json:"resource_type"`
+	CreatedAt    client.Timestamp `json:"created_at"`
+	UpdatedAt    client.Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// listResponse is the body of GET /{resource}/{uuid}/storages.
+type listResponse struct {
+	PersistentStorages []Storage `json:"persistent_storages"`
+	FileStorages       []Storage `json:"file_storages"`
+}
+
+// CreateStorageDTO represents the data required to create a storage. Volumes
+// need Name; file mounts need Content, or IsDirectory for a directory.
+type CreateStorageDTO struct {
+	Type        Type    `json:"type"`
+	MountPath   string  `json:"mount_path"`
+	Name        *string `json:"name,omitempty"`
+	HostPath    *string `json:"host_path,omitempty"`
+	FsPath      *string `json:"fs_path,omitempty"`
+	Content     *string `json:"content,omitempty"`
+	IsDirectory *bool   `json:"is_directory,omitempty"`
+}
+
+// CreateStorageResponse represents the response when creating a storage.
+type CreateStorageResponse struct {
+	UUID string `json:"uuid"`
+}
+
+// UpdateStorageDTO represents the data required to update a storage.
+type UpdateStorageDTO struct {
+	Name        *string `json:"name,omitempty"`
+	MountPath   *string `json:"mount_path,omitempty"`
+	HostPath    *string `json:"host_path,omitempty"`
+	Content     *string `json:"content,omitempty"`
+	IsDirectory *bool   `json:"is_directory,omitempty"`
+}
+
+func (s *StorageInstance) path() string {
+	return fmt.Sprintf("%s/%s/storages", s.resource, s.uuid)
+}
+
+// List retrieves the volumes and file mounts of the resource.
+func (s *StorageInstance) List(ctx context.Context) (*[]Storage, error) {
+	if s.uuid == "" {
+		return nil, errors.New("UUID is required")
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, s.path(), "GET")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storages: %w", err)
+	}
+
+	response, err := client.DecodeResponse(body, &listResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	storages := make([]Storage, 0, len(response.PersistentStorages)+len(response.FileStorages))
+	for _, storage := range response.PersistentStorages {
+		storage.Type = Persistent
+		storages = append(storages, storage)
+	}
+	for _, storage := range response.FileStorages {
+		storage.Type = File
+		storages = append(storages, storage)
+	}
+
+	return &storages, nil
+}
+
+// Create adds a volume or file mount to the resource and returns its UUID.
+func (s *StorageInstance) Create(ctx context.Context, data *CreateStorageDTO) (*string, error) {
+	if s.uuid == "" {
+		return nil, errors.New("UUID is required")
+	}
+	if err := data.validate(); err != nil {
+		return nil, err
+	}
+
+	buf, err := client.EncodeRequest(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, s.path(), "POST", *buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage: %w", err)
+	}
+
+	response, err := client.DecodeResponse(body, &CreateStorageResponse{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response.UUID, nil
+}
+
+// Update updates a storage of the resource.
+func (s *StorageInstance) Update(ctx context.Context, storageUUID string, data *UpdateStorageDTO) error {
+	if s.uuid == "" || storageUUID == "" {
+		return errors.New("UUID is required")
+	}
+
+	buf, err := client.EncodeRequest(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, s.path()+"/"+storageUUID, "PATCH", *buf)
+	if err != nil {
+		return fmt.Errorf("failed to update storage %s: %w", storageUUID, err)
+	}
+	body.Close()
+
+	return nil
+}
+
+// Delete removes a storage from the resource.
+func (s *StorageInstance) Delete(ctx context.Context, storageUUID string) error {
+	if s.uuid == "" || storageUUID == "" {
+		return errors.New("UUID is required")
+	}
+
+	body, err := s.client.HttpRequestWithContext(ctx, s.path()+"/"+storageUUID, "DELETE")
+	if err != nil {
+		return fmt.Errorf("failed to delete storage %s: %w", storageUUID, err)
+	}
+	body.Close()
+
+	return nil
+}
+
+func (d *CreateStorageDTO) validate() error {
+	if d.MountPath == "" {
+		return errors.New("mount path is required")
+	}
+
+	switch d.Type {
+	case Persistent:
+		if d.Name == nil || *d.Name == "" {
+			return errors.New("name is required for persistent storages")
+		}
+	case File:
+		if d.Content == nil && (d.IsDirectory == nil || !*d.IsDirectory) {
+			return errors.New("content is required for file storages")
+		}
+	default:
+		return fmt.Errorf("unknown storage type %q", d.Type)
+	}
+
+	return nil
+}
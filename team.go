@@ -1,59 +1,95 @@
-package coolify_sdk
-
-import (
-	"fmt"
-	"time"
-
-	client "github.com/marconneves/coolify-sdk-go/client"
-)
-
-type TeamInstance struct {
-	client *client.Client
-}
-
-type Team struct {
-	Id          int       `json:"id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-}
-
-func (t *TeamInstance) List() (*[]Team, error) {
-	body, err := t.client.HttpRequest("teams", "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &[]Team{})
-}
-
-func (t *TeamInstance) Get(id int) (*Team, error) {
-	body, err := t.client.HttpRequest(fmt.Sprintf("teams/%v", id), "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &Team{})
-}
-
-type Member struct {
-	Id                   int     `json:"id"`
-	Name                 string  `json:"name"`
-	Email                string  `json:"email"`
-	EmailVerifiedAt      *string `json:"email_verified_at"`
-	CreatedAt            string  `json:"created_at"`
-	UpdatedAt            string  `json:"updated_at"`
-	TwoFactorConfirmedAt *string `json:"two_factor_confirmed_at"`
-	ForcePasswordReset   bool    `json:"force_password_reset"`
-	MarketingEmails      bool    `json:"marketing_emails"`
-}
-
-func (t *TeamInstance) Members(id int) (*[]Member, error) {
-	body, err := t.client.HttpRequest(fmt.Sprintf("teams/%v/members", id), "GET")
-	if err != nil {
-		return nil, err
-	}
-
-	return client.DecodeResponse(body, &[]Member{})
-}
+package coolify_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+)
+
+type TeamInstance struct {
+	client *client.Client
+}
+
+type Team struct {
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	CreatedAt   Timestamp `json:"created_at"`
+	UpdatedAt   Timestamp `json:"updated_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// List retrieves all teams.
+// Deprecated: Use ListWithContext instead.
+func (t *TeamInstance) List() (*[]Team, error) {
+	return t.ListWithContext(context.Background())
+}
+
+// ListWithContext retrieves all teams.
+func (t *TeamInstance) ListWithContext(ctx context.Context) (*[]Team, error) {
+	body, err := t.client.HttpRequestWithContext(ctx, "teams", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &[]Team{})
+}
+
+// Get retrieves a team by ID.
+// Deprecated: Use GetWithContext instead.
+func (t *TeamInstance) Get(id int) (*Team, error) {
+	return t.GetWithContext(context.Background(), id)
+}
+
+// GetWithContext retrieves a team by ID.
+func (t *TeamInstance) GetWithContext(ctx context.Context, id int) (*Team, error) {
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("teams/%v", id), "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &Team{})
+}
+
+// Current retrieves the team the API token belongs to.
+func (t *TeamInstance) Current(ctx context.Context) (*Team, error) {
+	body, err := t.client.HttpRequestWithContext(ctx, "teams/current", "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &Team{})
+}
+
+type Member struct {
+	Id                   int       `json:"id"`
+	Name                 string    `json:"name"`
+	Email                string    `json:"email"`
+	EmailVerifiedAt      Timestamp `json:"email_verified_at"`
+	CreatedAt            Timestamp `json:"created_at"`
+	UpdatedAt            Timestamp `json:"updated_at"`
+	TwoFactorConfirmedAt Timestamp `json:"two_factor_confirmed_at"`
+	ForcePasswordReset   bool      `json:"force_password_reset"`
+	MarketingEmails      bool      `json:"marketing_emails"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// Members lists the members of a team.
+// Deprecated: Use MembersWithContext instead.
+func (t *TeamInstance) Members(id int) (*[]Member, error) {
+	return t.MembersWithContext(context.Background(), id)
+}
+
+// MembersWithContext lists the members of a team.
+func (t *TeamInstance) MembersWithContext(ctx context.Context, id int) (*[]Member, error) {
+	body, err := t.client.HttpRequestWithContext(ctx, fmt.Sprintf("teams/%v/members", id), "GET")
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DecodeResponse(body, &[]Member{})
+}
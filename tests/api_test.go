@@ -0,0 +1,94 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+func TestApiToggle(t *testing.T) {
+	setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	cases := map[string]struct {
+		Toggle  func() error
+		Enabled bool
+	}{
+		"Disable": {
+			Toggle:  func() error { return coolify.Api.DisableWithContext(ctx) },
+			Enabled: false,
+		},
+		"Enable": {
+			Toggle:  func() error { return coolify.Api.EnableWithContext(ctx) },
+			Enabled: true,
+		},
+	}
+
+	for _, testName := range []string{"Disable", "Enable"} {
+		testComponent := cases[testName]
+		t.Run(testName, func(t *testing.T) {
+			if errors := testComponent.Toggle(); errors != nil {
+				t.Fatalf("Error: %v", errors)
+			}
+
+			enabled, err := coolify.Api.Status(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if enabled != testComponent.Enabled {
+				t.Errorf("got enabled %v, want %v", enabled, testComponent.Enabled)
+			}
+		})
+	}
+}
+
+func TestApiToggleRefused(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "You are not allowed to enable the API."}`))
+	}))
+	defer server.Close()
+
+	err := sdk.Init(server.URL, apiKey).Api.EnableWithContext(context.Background())
+
+	var apiErr *sdk.ApiError
+	if !errors.As(err, &apiErr) || apiErr.Action != "enable" {
+		t.Fatalf("got %v, want an *ApiError", err)
+	}
+
+	var statusErr *client.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusForbidden || statusErr.Message != "You are not allowed to enable the API." {
+		t.Errorf("got %+v", statusErr)
+	}
+}
+
+func TestApiStatusBypassesCache(t *testing.T) {
+	setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey, client.WithCache(client.NewCache(client.CacheOptions{TTL: time.Minute})))
+
+	if enabled, err := coolify.Api.Status(ctx); err != nil || !enabled {
+		t.Fatalf("got %v, %v, want an enabled API", enabled, err)
+	}
+
+	// Disable the API through another client, so that the cache is not
+	// purged.
+	if err := sdk.Init(host, apiKey).Api.DisableWithContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	enabled, err := coolify.Api.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enabled {
+		t.Error("Status answered from the cache")
+	}
+}
@@ -0,0 +1,140 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/bulk"
+	"github.com/marconneves/coolify-sdk-go/database"
+)
+
+func TestBulkRun(t *testing.T) {
+	failure := errors.New("boom")
+	uuids := []string{"a", "b", "c", "d", "e", "f"}
+
+	cases := map[string]struct {
+		Options bulk.Options
+		Fail    string
+		Failed  []string
+		Skipped bool
+	}{
+		"AllSucceed": {
+			Options: bulk.Options{Concurrency: 2},
+		},
+		"BestEffort": {
+			Options: bulk.Options{Concurrency: 2},
+			Fail:    "b",
+			Failed:  []string{"b"},
+		},
+		"FailFast": {
+			Options: bulk.Options{Concurrency: 1, Mode: bulk.FailFast},
+			Fail:    "b",
+			Failed:  []string{"b", "c", "d", "e", "f"},
+			Skipped: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var running, peak atomic.Int32
+			fn := func(ctx context.Context, uuid string) error {
+				if n := running.Add(1); n > peak.Load() {
+					peak.Store(n)
+				}
+				defer running.Add(-1)
+				time.Sleep(time.Millisecond)
+				if uuid == testComponent.Fail {
+					return failure
+				}
+				return nil
+			}
+
+			results, errors := bulk.Run(context.Background(), uuids, fn, testComponent.Options)
+
+			if len(results) != len(uuids) {
+				t.Fatalf("got %d results, want %d", len(results), len(uuids))
+			}
+			for i, result := range results {
+				if result.UUID != uuids[i] {
+					t.Errorf("result %d is for %s, want %s", i, result.UUID, uuids[i])
+				}
+			}
+			if !slices.Equal(results.Failed(), testComponent.Failed) {
+				t.Errorf("got failed %v, want %v", results.Failed(), testComponent.Failed)
+			}
+			if testComponent.Fail == "" && errors != nil {
+				t.Errorf("unexpected error %v", errors)
+			}
+			if testComponent.Fail != "" && (!isError(errors, failure) || errors.Error() != "b: boom") {
+				t.Errorf("got error %v", errors)
+			}
+			if testComponent.Skipped && !isError(results[len(results)-1].Err, bulk.ErrSkipped) {
+				t.Errorf("expected the last item to be skipped, got %v", results[len(results)-1].Err)
+			}
+			if int(peak.Load()) > testComponent.Options.Concurrency {
+				t.Errorf("ran %d items at once, limit is %d", peak.Load(), testComponent.Options.Concurrency)
+			}
+		})
+	}
+}
+
+func TestBulkRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	results, err := bulk.Run(ctx, []string{"a", "b"}, func(context.Context, string) error {
+		called = true
+		return nil
+	}, bulk.Options{})
+
+	if called {
+		t.Error("fn was called with a canceled context")
+	}
+	if !isError(err, context.Canceled) || !isError(results[0].Err, context.Canceled) {
+		t.Errorf("got %v", err)
+	}
+}
+
+func TestBulkDatabases(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	tagged := fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "orders", Status: "exited", Tags: []database.Tag{{Name: "critical"}}})
+	fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "cache", Status: "exited"})
+
+	uuids, err := bulk.DatabaseUUIDs(ctx, coolify.Database, database.Filter{Tag: "critical"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(uuids, []string{tagged}) {
+		t.Fatalf("got %v, want [%s]", uuids, tagged)
+	}
+
+	results, err := bulk.StartDatabases(ctx, coolify.Database, append(uuids, "missing"), bulk.Options{})
+	if err == nil || !slices.Equal(results.Failed(), []string{"missing"}) {
+		t.Fatalf("got failed %v, error %v", results.Failed(), err)
+	}
+
+	db, err := coolify.Database.Get(ctx, tagged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Status != "running:healthy" {
+		t.Errorf("got status %s", db.Status)
+	}
+
+	results, err = bulk.ValidateServers(ctx, coolify.Server, []string{"ykwgwcg0cgk8owsk4gg8wwo4", "lcs8ggw8cos48kw0sc0sk0gc"}, bulk.Options{Mode: bulk.FailFast})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Failed()) != 0 {
+		t.Errorf("unexpected failures %v", results.Failed())
+	}
+}
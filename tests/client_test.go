@@ -0,0 +1,493 @@
+package coolify_sdk_test
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/marconneves/coolify-sdk-go/client"
+)
+
+type recordingObserver struct {
+	results []client.Result
+}
+
+func (o *recordingObserver) Start(ctx context.Context, req client.Request) (context.Context, func(client.Result)) {
+	return ctx, func(result client.Result) {
+		o.results = append(o.results, result)
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	cases := map[string]struct {
+		Method   string
+		Path     string
+		Status   int
+		Failures int
+		Retries  int
+		Error    bool
+	}{
+		"RecoversAfterRetry": {
+			Method:   "GET",
+			Failures: 2,
+			Retries:  2,
+			Error:    false,
+		},
+		"GivesUpAfterMaxRetries": {
+			Method:   "GET",
+			Failures: 5,
+			Retries:  3,
+			Error:    true,
+		},
+		"DoesNotRetryMutations": {
+			Method:   "POST",
+			Failures: 1,
+			Retries:  0,
+			Error:    true,
+		},
+		"DoesNotRetryActionsOn5xx": {
+			Method:   "GET",
+			Path:     "databases/db/restart",
+			Failures: 1,
+			Retries:  0,
+			Error:    true,
+		},
+		"RetriesThrottledActions": {
+			Method:   "GET",
+			Path:     "databases/db/restart",
+			Status:   http.StatusTooManyRequests,
+			Failures: 1,
+			Retries:  1,
+			Error:    false,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls <= testComponent.Failures {
+					w.WriteHeader(cmp.Or(testComponent.Status, http.StatusServiceUnavailable))
+					return
+				}
+				w.Write([]byte(`[]`))
+			}))
+			defer server.Close()
+
+			observer := &recordingObserver{}
+			c := client.NewClient(server.URL, "token", client.WithRetries(3, time.Millisecond), client.WithObserver(observer))
+
+			_, err := c.HttpRequestWithContext(context.Background(), cmp.Or(testComponent.Path, "servers"), testComponent.Method)
+
+			if err != nil && !testComponent.Error {
+				t.Errorf("request failed unexpectedly: %v", err)
+			} else if err == nil && testComponent.Error {
+				t.Errorf("request succeeded unexpectedly")
+			}
+
+			if len(observer.results) != 1 || observer.results[0].Retries != testComponent.Retries {
+				t.Errorf("expected %d retries, got %v", testComponent.Retries, observer.results)
+			}
+		})
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	cases := map[string]struct {
+		Options  []client.Option
+		Headers  map[string]string
+		Requests int
+		MinTime  time.Duration
+		MaxTime  time.Duration
+	}{
+		"Unlimited": {
+			Requests: 5,
+			MaxTime:  50 * time.Millisecond,
+		},
+		"WithinBurst": {
+			Options:  []client.Option{client.WithRateLimit(10, 5)},
+			Requests: 5,
+			MaxTime:  50 * time.Millisecond,
+		},
+		"PacedAfterBurst": {
+			Options:  []client.Option{client.WithRateLimit(50, 2)},
+			Requests: 5,
+			MinTime:  55 * time.Millisecond,
+		},
+		"RemainingExhausted": {
+			Options:  []client.Option{client.WithRateLimit(20, 5)},
+			Headers:  map[string]string{"X-RateLimit-Remaining": "0"},
+			Requests: 2,
+			MinTime:  45 * time.Millisecond,
+		},
+		"RetryAfterPausesLimiter": {
+			Options:  []client.Option{client.WithRateLimit(0, 1)},
+			Headers:  map[string]string{"Retry-After": "1"},
+			Requests: 2,
+			MinTime:  time.Second,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for key, value := range testComponent.Headers {
+					w.Header().Set(key, value)
+				}
+				w.Write([]byte(`[]`))
+			}))
+			defer server.Close()
+
+			c := client.NewClient(server.URL, "token", testComponent.Options...)
+
+			start := time.Now()
+			for range testComponent.Requests {
+				body, err := c.HttpRequestWithContext(context.Background(), "servers", "GET")
+				if err != nil {
+					t.Fatal(err)
+				}
+				body.Close()
+			}
+			elapsed := time.Since(start)
+
+			if elapsed < testComponent.MinTime {
+				t.Errorf("took %v, want at least %v", elapsed, testComponent.MinTime)
+			}
+			if testComponent.MaxTime > 0 && elapsed > testComponent.MaxTime {
+				t.Errorf("took %v, want at most %v", elapsed, testComponent.MaxTime)
+			}
+		})
+	}
+}
+
+func TestClientRetryAfter(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "token", client.WithRetries(1, time.Millisecond))
+
+	start := time.Now()
+	body, err := c.HttpRequestWithContext(context.Background(), "servers", "GET")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least the Retry-After delay", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	limiter := client.NewRateLimiter(1, 1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClientCache(t *testing.T) {
+	type step struct {
+		Method string
+		Path   string
+		Sleep  time.Duration
+	}
+
+	cases := map[string]struct {
+		Options    client.CacheOptions
+		Steps      []step
+		Invalidate string
+		Requests   int
+	}{
+		"RepeatedGet": {
+			Options:  client.CacheOptions{TTL: time.Minute},
+			Steps:    []step{{Method: "GET", Path: "servers"}, {Method: "GET", Path: "servers"}, {Method: "GET", Path: "servers"}},
+			Requests: 1,
+		},
+		"Expired": {
+			Options:  client.CacheOptions{TTL: 10 * time.Millisecond},
+			Steps:    []step{{Method: "GET", Path: "servers", Sleep: 20 * time.Millisecond}, {Method: "GET", Path: "servers"}},
+			Requests: 2,
+		},
+		"ResourceTTL": {
+			Options:  client.CacheOptions{TTL: time.Minute, Resources: map[string]time.Duration{"teams": 0}},
+			Steps:    []step{{Method: "GET", Path: "teams"}, {Method: "GET", Path: "teams"}, {Method: "GET", Path: "servers"}, {Method: "GET", Path: "servers"}},
+			Requests: 3,
+		},
+		"MutationInvalidatesResource": {
+			Options:  client.CacheOptions{TTL: time.Minute},
+			Steps:    []step{{Method: "GET", Path: "servers"}, {Method: "GET", Path: "projects"}, {Method: "PATCH", Path: "servers/a"}, {Method: "GET", Path: "servers"}, {Method: "GET", Path: "projects"}},
+			Requests: 4,
+		},
+		"ActionsAreNotCached": {
+			Options:  client.CacheOptions{TTL: time.Minute},
+			Steps:    []step{{Method: "GET", Path: "databases/a"}, {Method: "GET", Path: "databases/a/start"}, {Method: "GET", Path: "databases/a/start"}, {Method: "GET", Path: "databases/a"}},
+			Requests: 4,
+		},
+		"ExplicitInvalidation": {
+			Options:    client.CacheOptions{TTL: time.Minute},
+			Steps:      []step{{Method: "GET", Path: "servers/a/resources"}},
+			Invalidate: "servers",
+			Requests:   2,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Write([]byte(`[]`))
+			}))
+			defer server.Close()
+
+			cache := client.NewCache(testComponent.Options)
+			c := client.NewClient(server.URL, "token", client.WithCache(cache))
+
+			run := func(s step) {
+				body, err := c.HttpRequestWithContext(context.Background(), s.Path, s.Method)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body.Close()
+				time.Sleep(s.Sleep)
+			}
+
+			for _, s := range testComponent.Steps {
+				run(s)
+			}
+			if testComponent.Invalidate != "" {
+				cache.Invalidate(testComponent.Invalidate)
+				run(testComponent.Steps[0])
+			}
+
+			if int(requests.Load()) != testComponent.Requests {
+				t.Errorf("got %d requests, want %d", requests.Load(), testComponent.Requests)
+			}
+		})
+	}
+}
+
+func TestClientCacheSingleflight(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(`[{"uuid": "a"}]`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "token", client.WithCache(client.NewCache(client.CacheOptions{TTL: time.Minute})))
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 10)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			body, err := c.HttpRequestWithContext(context.Background(), "servers", "GET")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer body.Close()
+			data := new(strings.Builder)
+			io.Copy(data, body)
+			bodies[i] = data.String()
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if requests.Load() != 1 {
+		t.Errorf("got %d requests, want 1", requests.Load())
+	}
+	for _, body := range bodies {
+		if body != `[{"uuid": "a"}]` {
+			t.Errorf("unexpected body %q", body)
+		}
+	}
+}
+
+func TestClientCacheFirstCallerCancels(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(`[{"uuid": "a"}]`))
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "token", client.WithCache(client.NewCache(client.CacheOptions{TTL: time.Minute})))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := c.HttpRequestWithContext(ctx, "servers", "GET")
+		first <- err
+	}()
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 5)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			body, err := c.HttpRequestWithContext(context.Background(), "servers", "GET")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer body.Close()
+			data := new(strings.Builder)
+			io.Copy(data, body)
+			bodies[i] = data.String()
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	if err := <-first; err != context.Canceled {
+		t.Errorf("first caller got %v, want %v", err, context.Canceled)
+	}
+	close(release)
+	wg.Wait()
+
+	if requests.Load() != 1 {
+		t.Errorf("got %d requests, want 1", requests.Load())
+	}
+	for _, body := range bodies {
+		if body != `[{"uuid": "a"}]` {
+			t.Errorf("unexpected body %q", body)
+		}
+	}
+}
+
+func TestClientCacheInvalidatesInFlight(t *testing.T) {
+	var gets atomic.Int32
+	var state atomic.Value
+	state.Store("old")
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			state.Store("new")
+			w.Write([]byte(`{}`))
+			return
+		}
+		body := state.Load().(string)
+		if gets.Add(1) == 1 {
+			<-release
+		}
+		w.Write([]byte(`"` + body + `"`))
+	}))
+	defer server.Close()
+	// Unblock the first GET even when a later one wrongly waits for it.
+	unblock := sync.OnceFunc(func() { close(release) })
+	timer := time.AfterFunc(time.Second, unblock)
+	defer timer.Stop()
+
+	c := client.NewClient(server.URL, "token", client.WithCache(client.NewCache(client.CacheOptions{TTL: time.Minute})))
+	read := func() string {
+		body, err := c.HttpRequestWithContext(context.Background(), "servers", "GET")
+		if err != nil {
+			t.Error(err)
+			return ""
+		}
+		defer body.Close()
+		data, _ := io.ReadAll(body)
+		return string(data)
+	}
+
+	first := make(chan string, 1)
+	go func() { first <- read() }()
+	for gets.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	body, err := c.HttpRequestWithContext(context.Background(), "servers/a", "PATCH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.Close()
+
+	if got := read(); got != `"new"` {
+		t.Errorf("GET after the update got %s, want the updated body", got)
+	}
+	unblock()
+	<-first
+}
+
+type countingTransport struct {
+	calls atomic.Int64
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.calls.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientWithTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	cases := map[string]func(shared *http.Client, transport http.RoundTripper) []client.Option{
+		"TransportFirst": func(shared *http.Client, transport http.RoundTripper) []client.Option {
+			return []client.Option{client.WithTransport(transport), client.WithHTTPClient(shared)}
+		},
+		"HTTPClientFirst": func(shared *http.Client, transport http.RoundTripper) []client.Option {
+			return []client.Option{client.WithHTTPClient(shared), client.WithTransport(transport)}
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			shared := &http.Client{Timeout: time.Second}
+			transport := &countingTransport{}
+			c := client.NewClient(server.URL, "token", testComponent(shared, transport)...)
+
+			body, err := c.HttpRequestWithContext(context.Background(), "servers", "GET")
+			if err != nil {
+				t.Fatal(err)
+			}
+			body.Close()
+
+			if transport.calls.Load() != 1 {
+				t.Errorf("transport used %d times, want 1", transport.calls.Load())
+			}
+			if shared.Transport != nil {
+				t.Error("the shared *http.Client was modified")
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package coolify_sdk_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marconneves/coolify-sdk-go/config"
+)
+
+const testConfig = `current_profile: staging
+profiles:
+  staging:
+    host: https://staging.example.com/
+    token: staging-token
+    default_team: 2
+  production:
+    host: https://coolify.example.com
+    token_command: echo production-token
+  lab:
+    host: https://lab.internal
+    token: lab-token
+    tls:
+      ca_file: /does/not/exist.pem
+`
+
+func TestResolveProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(testConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		Profile string
+		Env     map[string]string
+		Host    string
+		Token   string
+		Error   bool
+	}{
+		"CurrentProfile": {
+			Host:  "https://staging.example.com",
+			Token: "staging-token",
+		},
+		"ProfileFromEnv": {
+			Env:   map[string]string{config.EnvProfile: "production"},
+			Host:  "https://coolify.example.com",
+			Token: "production-token",
+		},
+		"OptionBeatsEnv": {
+			Profile: "staging",
+			Env:     map[string]string{config.EnvProfile: "production"},
+			Host:    "https://staging.example.com",
+			Token:   "staging-token",
+		},
+		"EnvOverridesProfile": {
+			Env:   map[string]string{config.EnvHost: "http://localhost:8000", config.EnvToken: "env-token"},
+			Host:  "http://localhost:8000",
+			Token: "env-token",
+		},
+		"UnknownProfile": {
+			Profile: "missing",
+			Error:   true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			profile, err := config.Resolve(config.Options{
+				Path:    path,
+				Profile: testComponent.Profile,
+				Getenv:  func(key string) string { return testComponent.Env[key] },
+			})
+
+			if err != nil && !testComponent.Error {
+				t.Fatalf("Resolve failed unexpectedly: %v", err)
+			} else if err == nil && testComponent.Error {
+				t.Fatalf("Resolve succeeded unexpectedly")
+			}
+
+			if err == nil && (profile.Host != testComponent.Host || profile.Token != testComponent.Token) {
+				t.Errorf("expected %s/%s, got %s/%s", testComponent.Host, testComponent.Token, profile.Host, profile.Token)
+			}
+		})
+	}
+}
+
+func TestOpenProfile(t *testing.T) {
+	fake := setup(t)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(testConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{config.EnvHost: fake.URL, config.EnvToken: fake.Token}
+	client, err := config.Open(config.Options{Path: path, Getenv: func(key string) string { return env[key] }})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if _, err := client.Team.List(); err != nil {
+		t.Errorf("List failed: %v", err)
+	}
+
+	if _, err := config.Open(config.Options{Path: path, Profile: "lab"}); err == nil {
+		t.Errorf("expected the missing CA file to fail")
+	}
+}
+
+func TestResolveDefaultPath(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "coolify"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "coolify", "config.yaml"), []byte(testConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{"XDG_CONFIG_HOME": dir}
+	profile, err := config.Resolve(config.Options{Getenv: func(key string) string { return env[key] }})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if profile.Name != "staging" {
+		t.Errorf("expected the injected XDG_CONFIG_HOME to be used, got profile %q", profile.Name)
+	}
+}
+
+func TestResolveTokenCommandTimeout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	data := "profiles:\n  slow:\n    host: https://coolify.example.com\n    token_command: exec sleep 10\n"
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	_, err := config.Resolve(config.Options{
+		Path:                path,
+		Profile:             "slow",
+		Getenv:              func(string) string { return "" },
+		TokenCommandTimeout: 50 * time.Millisecond,
+	})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected a timeout, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("token command ran for %v", elapsed)
+	}
+}
@@ -0,0 +1,57 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+)
+
+func TestCanceledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	var client = sdk.Init(server.URL, apiKey)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := map[string]func() error{
+		"Server": func() error {
+			_, err := client.Server.ListWithContext(ctx)
+			return err
+		},
+		"PrivateKey": func() error {
+			_, err := client.PrivateKey.ListWithContext(ctx)
+			return err
+		},
+		"Project": func() error {
+			_, err := client.Project.ListWithContext(ctx)
+			return err
+		},
+		"Team": func() error {
+			_, err := client.Team.ListWithContext(ctx)
+			return err
+		},
+		"Api": func() error {
+			return client.Api.EnableWithContext(ctx)
+		},
+		"HealthCheck": func() error {
+			_, err := client.HealthCheckWithContext(ctx)
+			return err
+		},
+	}
+
+	for testName, call := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := call(); !errors.Is(err, context.Canceled) {
+				t.Errorf("expected context.Canceled, got %v", err)
+			}
+		})
+	}
+}
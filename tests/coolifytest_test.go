@@ -0,0 +1,77 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/coolifytest"
+)
+
+func TestFakeDatabaseLifecycle(t *testing.T) {
+	setup(t)
+
+	var client = sdk.Init(host, apiKey)
+	ctx := context.Background()
+
+	uuid, err := client.Database.CreatePostgreSQL(ctx, &sdk.CreateDatabasePostgresDTO{
+		ServerUUID:  "ykwgwcg0cgk8owsk4gg8wwo4",
+		ProjectUUID: "v8ckogcwgo0sgsogwooww84c",
+		Environment: "dev",
+		Name:        stringPtr("orders"),
+	})
+	if err != nil {
+		t.Fatalf("CreatePostgreSQL failed: %v", err)
+	}
+
+	if err := client.Database.Start(ctx, *uuid); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	db, err := client.Database.Get(ctx, *uuid)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if db.Name != "orders" || db.Status != "running:healthy" || db.PostgresUser != "postgres" {
+		t.Errorf("unexpected database: %+v", db)
+	}
+
+	resources, err := client.Server.Resources("ykwgwcg0cgk8owsk4gg8wwo4")
+	if err != nil || len(*resources) != 1 {
+		t.Errorf("expected the database among server resources, got %v, %v", resources, err)
+	}
+
+	_, err = client.Database.CreatePostgreSQL(ctx, &sdk.CreateDatabasePostgresDTO{ProjectUUID: "v8ckogcwgo0sgsogwooww84c"})
+	if err == nil || !strings.Contains(err.Error(), "422") {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
+
+func TestFakeFaults(t *testing.T) {
+	fake := setup(t)
+
+	var client = sdk.Init(host, apiKey)
+
+	fake.Inject(coolifytest.Fault{Path: "servers", Status: 503, Times: 1})
+	if _, err := client.Server.List(); err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("expected an injected 503, got %v", err)
+	}
+	if _, err := client.Server.List(); err != nil {
+		t.Errorf("fault should only apply once, got %v", err)
+	}
+
+	fake.Inject(coolifytest.Fault{Method: "GET", Path: "teams", Status: 429, RetryAfter: time.Second})
+	if _, err := client.Team.List(); err == nil || !strings.Contains(err.Error(), "429") {
+		t.Errorf("expected an injected 429, got %v", err)
+	}
+
+	fake.ClearFaults()
+	fake.Inject(coolifytest.Fault{Latency: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.Project.ListWithContext(ctx); err == nil {
+		t.Errorf("expected the injected latency to exceed the deadline")
+	}
+}
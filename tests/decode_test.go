@@ -0,0 +1,197 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/database"
+)
+
+func TestUnmarshalLenient(t *testing.T) {
+	cases := map[string]struct {
+		Payload string
+		Check   func(t *testing.T, srv *sdk.Server)
+		Error   bool
+	}{
+		"EmptyProxyArray": {
+			Payload: `{"uuid": "a", "proxy": []}`,
+			Check: func(t *testing.T, srv *sdk.Server) {
+				if srv.Proxy != nil {
+					t.Errorf("expected no proxy, got %+v", srv.Proxy)
+				}
+			},
+		},
+		"NumericStrings": {
+			Payload: `{"port": "2222", "unreachable_count": "", "settings": {"is_build_server": "1", "is_usable": 0, "concurrent_builds": "4"}}`,
+			Check: func(t *testing.T, srv *sdk.Server) {
+				if srv.Port != 2222 || srv.UnreachableCount != 0 {
+					t.Errorf("got port %d, unreachable count %d", srv.Port, srv.UnreachableCount)
+				}
+				if !srv.Settings.IsBuildServer || srv.Settings.IsUsable || srv.Settings.ConcurrentBuilds != 4 {
+					t.Errorf("unexpected settings %+v", srv.Settings)
+				}
+			},
+		},
+		"NumberAsString": {
+			Payload: `{"user": 1000}`,
+			Check: func(t *testing.T, srv *sdk.Server) {
+				if srv.User != "1000" {
+					t.Errorf("got user %q", srv.User)
+				}
+			},
+		},
+		"TimestampFormats": {
+			Payload: `{"created_at": "2024-05-01 10:20:30", "updated_at": "2024-05-01T10:20:30.123456Z", "settings": {"created_at": "", "updated_at": "2024-05-01"}}`,
+			Check: func(t *testing.T, srv *sdk.Server) {
+				if !srv.CreatedAt.Equal(time.Date(2024, 5, 1, 10, 20, 30, 0, time.UTC)) {
+					t.Errorf("got created_at %v", srv.CreatedAt)
+				}
+				if srv.UpdatedAt.Nanosecond() != 123456000 {
+					t.Errorf("got updated_at %v", srv.UpdatedAt)
+				}
+				if !srv.Settings.CreatedAt.IsZero() || srv.Settings.UpdatedAt.Day() != 1 {
+					t.Errorf("unexpected settings timestamps %+v", srv.Settings)
+				}
+			},
+		},
+		"UnknownFields": {
+			Payload: `{"uuid": "a", "hetzner_server_id": 42, "settings": {"sentinel_token": "x"}}`,
+			Check: func(t *testing.T, srv *sdk.Server) {
+				if string(srv.Extra["hetzner_server_id"]) != "42" {
+					t.Errorf("got extra %v", srv.Extra)
+				}
+				if string(srv.Settings.Extra["sentinel_token"]) != `"x"` {
+					t.Errorf("got settings extra %v", srv.Settings.Extra)
+				}
+			},
+		},
+		"InvalidJSON": {
+			Payload: `{"uuid": `,
+			Error:   true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			srv := &sdk.Server{}
+			errors := client.Unmarshal([]byte(testComponent.Payload), srv)
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+			if testComponent.Check != nil {
+				testComponent.Check(t, srv)
+			}
+		})
+	}
+}
+
+func TestServerListWithEmptyProxy(t *testing.T) {
+	setup(t)
+
+	servers, err := sdk.Init(host, apiKey).Server.ListWithContext(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*servers) != 3 {
+		t.Errorf("got %d servers, want 3", len(*servers))
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	cases := map[string]struct {
+		Payload string
+		Want    time.Time
+		Encoded string
+		Error   bool
+	}{
+		"Microseconds": {
+			Payload: `"2024-05-01T10:20:30.123456Z"`,
+			Want:    time.Date(2024, 5, 1, 10, 20, 30, 123456000, time.UTC),
+			Encoded: `"2024-05-01T10:20:30.123456Z"`,
+		},
+		"SQLFormat": {
+			Payload: `"2024-05-01 10:20:30"`,
+			Want:    time.Date(2024, 5, 1, 10, 20, 30, 0, time.UTC),
+			Encoded: `"2024-05-01T10:20:30.000000Z"`,
+		},
+		"Null": {
+			Payload: `null`,
+			Encoded: `null`,
+		},
+		"Empty": {
+			Payload: `""`,
+			Encoded: `null`,
+		},
+		"Invalid": {
+			Payload: `"yesterday"`,
+			Error:   true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var member sdk.Member
+			errors := client.Unmarshal([]byte(`{"email_verified_at": `+testComponent.Payload+`}`), &member)
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+			if testComponent.Error {
+				return
+			}
+
+			if !member.EmailVerifiedAt.Equal(testComponent.Want) {
+				t.Errorf("got %v, want %v", member.EmailVerifiedAt, testComponent.Want)
+			}
+			encoded, err := member.EmailVerifiedAt.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(encoded) != testComponent.Encoded {
+				t.Errorf("encoded as %s, want %s", encoded, testComponent.Encoded)
+			}
+		})
+	}
+}
+
+func TestDatabaseTimestamps(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	first := fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "first"})
+	second := fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "second", CreatedAt: sdk.Timestamp{Time: time.Now().Add(time.Hour)}})
+
+	if err := coolify.Database.Start(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+
+	databases, err := coolify.Database.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byUUID := map[string]sdk.Database{}
+	for _, db := range *databases {
+		byUUID[db.UUID] = db
+	}
+	if !byUUID[first].CreatedAt.Before(byUUID[second].CreatedAt.Time) {
+		t.Errorf("databases cannot be ordered by age")
+	}
+	if byUUID[first].StartedAt.IsZero() || !byUUID[second].StartedAt.IsZero() {
+		t.Errorf("unexpected started_at values %v and %v", byUUID[first].StartedAt, byUUID[second].StartedAt)
+	}
+	if !byUUID[first].DeletedAt.IsZero() {
+		t.Errorf("expected a null deleted_at")
+	}
+}
@@ -0,0 +1,151 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/database"
+	"github.com/marconneves/coolify-sdk-go/destination"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+func TestCreateDestination(t *testing.T) {
+	cases := map[string]struct {
+		Data  destination.CreateDestinationDTO
+		Type  destination.Type
+		Error bool
+	}{
+		"Standalone": {
+			Data: destination.CreateDestinationDTO{ServerUUID: "ykwgwcg0cgk8owsk4gg8wwo4", Name: "internal", Network: "internal"},
+			Type: destination.Standalone,
+		},
+		"Swarm": {
+			Data: destination.CreateDestinationDTO{ServerUUID: "swarm-manager", Network: "overlay", Type: destination.Swarm},
+			Type: destination.Swarm,
+		},
+		"SwarmOnStandaloneServer": {
+			Data:  destination.CreateDestinationDTO{ServerUUID: "ykwgwcg0cgk8owsk4gg8wwo4", Network: "overlay", Type: destination.Swarm},
+			Error: true,
+		},
+		"DuplicateNetwork": {
+			Data:  destination.CreateDestinationDTO{ServerUUID: "ykwgwcg0cgk8owsk4gg8wwo4", Network: "coolify"},
+			Error: true,
+		},
+		"UnknownServer": {
+			Data:  destination.CreateDestinationDTO{ServerUUID: "missing", Network: "internal"},
+			Error: true,
+		},
+		"UnknownType": {
+			Data:  destination.CreateDestinationDTO{ServerUUID: "ykwgwcg0cgk8owsk4gg8wwo4", Network: "internal", Type: "kubernetes"},
+			Error: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fake := setup(t)
+			fake.AddServer(server.Server{UUID: "swarm-manager", Name: "Swarm", IP: "10.0.0.4", Port: 22, User: "root", Settings: &server.Settings{IsSwarmManager: true}})
+			ctx := context.Background()
+			coolify := sdk.Init(host, apiKey)
+
+			uuid, errors := coolify.Destination.Create(ctx, &testComponent.Data)
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+			if testComponent.Error {
+				return
+			}
+
+			got, err := coolify.Destination.Get(ctx, *uuid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Network != testComponent.Data.Network || got.Type != testComponent.Type {
+				t.Errorf("got %+v", got)
+			}
+		})
+	}
+}
+
+func TestDefaultDestination(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+	fake.AddServer(server.Server{UUID: "custom-only", Name: "Custom", IP: "10.0.0.5", Port: 22, User: "root"})
+
+	seeded, err := coolify.Destination.Default(ctx, "custom-only")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := coolify.Destination.Delete(ctx, seeded.UUID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coolify.Destination.Default(ctx, "custom-only"); !isError(err, destination.ErrNoDefaultDestination) {
+		t.Errorf("got %v for a server without destinations", err)
+	}
+	_, err = coolify.Database.CreateRedis(ctx, &database.CreateDatabaseRedisDTO{
+		ServerUUID:      "custom-only",
+		ProjectUUID:     "v8ckogcwgo0sgsogwooww84c",
+		EnvironmentName: "production",
+	})
+	if client.StatusCode(err) != http.StatusUnprocessableEntity {
+		t.Errorf("got %v creating a database on a server without destinations", err)
+	}
+
+	for _, network := range []string{"blue", "green"} {
+		if _, err := coolify.Destination.Create(ctx, &destination.CreateDestinationDTO{ServerUUID: "custom-only", Network: network}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := coolify.Destination.Default(ctx, "custom-only"); !isError(err, destination.ErrNoDefaultDestination) {
+		t.Errorf("got %v for a server with several custom destinations", err)
+	}
+
+	internal, err := coolify.Destination.Create(ctx, &destination.CreateDestinationDTO{ServerUUID: "ykwgwcg0cgk8owsk4gg8wwo4", Network: "internal"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	destinations, err := coolify.Destination.List(ctx, "ykwgwcg0cgk8owsk4gg8wwo4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*destinations) != 2 {
+		t.Fatalf("got %d destinations, want 2", len(*destinations))
+	}
+	def, err := coolify.Destination.Default(ctx, "ykwgwcg0cgk8owsk4gg8wwo4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Network != "coolify" {
+		t.Errorf("default destination is on %q, want coolify", def.Network)
+	}
+
+	_, err = coolify.Database.CreateRedis(ctx, &database.CreateDatabaseRedisDTO{
+		ServerUUID:      "ykwgwcg0cgk8owsk4gg8wwo4",
+		ProjectUUID:     "v8ckogcwgo0sgsogwooww84c",
+		EnvironmentName: "production",
+	})
+	if err == nil {
+		t.Error("expected an error creating a database without a destination on a multi-network server")
+	}
+
+	_, err = coolify.Database.CreateRedis(ctx, &database.CreateDatabaseRedisDTO{
+		ServerUUID:      "ykwgwcg0cgk8owsk4gg8wwo4",
+		ProjectUUID:     "v8ckogcwgo0sgsogwooww84c",
+		EnvironmentName: "production",
+		DestinationUUID: internal,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := coolify.Destination.Delete(ctx, *internal); err == nil {
+		t.Error("expected an error deleting a destination in use")
+	}
+}
@@ -0,0 +1,91 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/database"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+func TestDetectDrift(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	dbUUID := fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "orders", DatabaseType: "standalone-postgresql", LimitsMemory: "0"})
+
+	baseline, err := coolify.Export(ctx, sdk.ExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := coolify.DetectDrift(ctx, baseline, sdk.DriftOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.HasDrift() {
+		t.Fatalf("unexpected drift right after export: %+v", report)
+	}
+
+	// A restart only changes volatile fields.
+	if err := coolify.Database.Restart(ctx, dbUUID); err != nil {
+		t.Fatal(err)
+	}
+	if err := coolify.Database.Update(ctx, dbUUID, &database.UpdateDatabaseDTO{LimitsMemory: stringPtr("512m")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := coolify.Server.UpdateWithContext(ctx, "lcs8ggw8cos48kw0sc0sk0gc", &server.UpdateServerDTO{Description: "changed in the UI"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := coolify.PrivateKey.DeleteWithContext(ctx, "fggkoowk084k8okc8wk4g4o4"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coolify.Project.CreateWithContext(ctx, &sdk.CreateProjectDTO{Name: stringPtr("Shadow IT")}); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		Options sdk.DriftOptions
+		Paths   []string
+	}{
+		"DefaultIgnores": {
+			Paths: []string{"Server.Description", "Database.LimitsMemory"},
+		},
+		"CustomIgnores": {
+			Options: sdk.DriftOptions{Ignore: append(sdk.DefaultDriftIgnore, "Database.Limits*", "Description")},
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			report, err := coolify.DetectDrift(ctx, baseline, testComponent.Options)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var paths []string
+			for _, changed := range report.Changed {
+				for _, field := range changed.Fields {
+					paths = append(paths, field.Path)
+				}
+			}
+			if len(paths) != len(testComponent.Paths) {
+				t.Fatalf("got changed fields %v, want %v", paths, testComponent.Paths)
+			}
+			for i := range paths {
+				if paths[i] != testComponent.Paths[i] {
+					t.Errorf("got changed fields %v, want %v", paths, testComponent.Paths)
+				}
+			}
+
+			if len(report.Added) != 1 || report.Added[0].Kind != "Project" || report.Added[0].Name != "Shadow IT" {
+				t.Errorf("unexpected added resources: %+v", report.Added)
+			}
+			if len(report.Removed) != 1 || report.Removed[0].Kind != "PrivateKey" {
+				t.Errorf("unexpected removed resources: %+v", report.Removed)
+			}
+		})
+	}
+}
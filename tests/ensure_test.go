@@ -0,0 +1,200 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/database"
+)
+
+func TestEnsure(t *testing.T) {
+	setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	cases := map[string]struct {
+		Ensure  func() (string, bool, error)
+		UUID    string
+		Created bool
+		Check   func(t *testing.T, uuid string)
+		Error   bool
+	}{
+		"ExistingProject": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsureProject(ctx, "Test Project", &sdk.CreateProjectDTO{Description: stringPtr("Updated")})
+			},
+			UUID: "v8ckogcwgo0sgsogwooww84c",
+			Check: func(t *testing.T, uuid string) {
+				project, err := coolify.Project.GetWithContext(ctx, uuid)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if project.Description == nil || *project.Description != "Updated" {
+					t.Errorf("description was not updated: %v", project.Description)
+				}
+			},
+		},
+		"NewProject": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsureProject(ctx, "New Project", nil)
+			},
+			Created: true,
+		},
+		"ProjectWithoutName": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsureProject(ctx, "", nil)
+			},
+			Error: true,
+		},
+		"ExistingPrivateKey": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsurePrivateKey(ctx, "Test Key", &sdk.CreatePrivateKeyDTO{PrivateKey: "rotated-private-key"})
+			},
+			UUID: "fggkoowk084k8okc8wk4g4o4",
+			Check: func(t *testing.T, uuid string) {
+				key, err := coolify.PrivateKey.GetWithContext(ctx, uuid)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if key.PrivateKey != "rotated-private-key" {
+					t.Errorf("key material was not updated")
+				}
+			},
+		},
+		"NewPrivateKey": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsurePrivateKey(ctx, "Deploy Key", &sdk.CreatePrivateKeyDTO{PrivateKey: "deploy-private-key"})
+			},
+			Created: true,
+		},
+		"ExistingServer": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsureServer(ctx, &sdk.CreateServerDTO{Name: "Renamed", IP: "10.0.0.2", PrivateKeyUUID: "fggkoowk084k8okc8wk4g4o4"})
+			},
+			UUID: "lcs8ggw8cos48kw0sc0sk0gc",
+			Check: func(t *testing.T, uuid string) {
+				srv, err := coolify.Server.GetWithContext(ctx, uuid)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if srv.Name != "Renamed" {
+					t.Errorf("name was not updated: %s", srv.Name)
+				}
+			},
+		},
+		"ServerOnOtherPort": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsureServer(ctx, &sdk.CreateServerDTO{Name: "Other", IP: "10.0.0.9", Port: 2222, PrivateKeyUUID: "fggkoowk084k8okc8wk4g4o4"})
+			},
+			Created: true,
+		},
+		"ServerWithoutIP": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsureServer(ctx, &sdk.CreateServerDTO{Name: "Nowhere"})
+			},
+			Error: true,
+		},
+		"DatabaseWithoutEnvironment": {
+			Ensure: func() (string, bool, error) {
+				return coolify.EnsureDatabase(ctx, &database.CreateDatabaseRedisDTO{ProjectUUID: "v8ckogcwgo0sgsogwooww84c", Name: stringPtr("cache")})
+			},
+			Error: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			uuid, created, errors := testComponent.Ensure()
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+			if testComponent.Error {
+				return
+			}
+
+			if created != testComponent.Created {
+				t.Errorf("got created %v, want %v", created, testComponent.Created)
+			}
+			if testComponent.UUID != "" && uuid != testComponent.UUID {
+				t.Errorf("got UUID %s, want %s", uuid, testComponent.UUID)
+			}
+			if testComponent.Check != nil {
+				testComponent.Check(t, uuid)
+			}
+
+			again, created, err := testComponent.Ensure()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if created || again != uuid {
+				t.Errorf("second call returned %s (created %v), want %s", again, created, uuid)
+			}
+		})
+	}
+}
+
+func TestEnsureDatabase(t *testing.T) {
+	setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	dto := &database.CreateDatabasePostgresDTO{
+		ServerUUID:  "ykwgwcg0cgk8owsk4gg8wwo4",
+		ProjectUUID: "v8ckogcwgo0sgsogwooww84c",
+		Environment: "production",
+		Name:        stringPtr("orders"),
+		Image:       stringPtr("postgres:16-alpine"),
+	}
+
+	uuid, created, err := coolify.EnsureDatabase(ctx, dto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !created {
+		t.Fatal("expected the database to be created")
+	}
+
+	// The same name in another environment is a different database.
+	staging := *dto
+	staging.Environment = "dev"
+	other, created, err := coolify.EnsureDatabase(ctx, &staging)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !created || other == uuid {
+		t.Errorf("expected a new database in dev, got %s (created %v)", other, created)
+	}
+
+	dto.Image = stringPtr("postgres:17-alpine")
+	again, created, err := coolify.EnsureDatabase(ctx, dto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created || again != uuid {
+		t.Fatalf("got %s (created %v), want %s", again, created, uuid)
+	}
+
+	db, err := coolify.Database.Get(ctx, uuid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Image != "postgres:17-alpine" {
+		t.Errorf("image was not updated: %s", db.Image)
+	}
+
+	// A database of another type with the same name is not reused.
+	redis := &database.CreateDatabaseRedisDTO{
+		ServerUUID:      dto.ServerUUID,
+		ProjectUUID:     dto.ProjectUUID,
+		EnvironmentName: dto.Environment,
+		Name:            dto.Name,
+	}
+	if _, _, err := coolify.EnsureDatabase(ctx, redis); err == nil {
+		t.Error("expected an error for a database of another type")
+	}
+}
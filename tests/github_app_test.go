@@ -0,0 +1,164 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+)
+
+func TestCreateGitHubApp(t *testing.T) {
+	cases := map[string]struct {
+		App   sdk.CreateGitHubAppDTO
+		Key   *sdk.CreatePrivateKeyDTO
+		Error bool
+	}{
+		"ExistingKey": {
+			App: sdk.CreateGitHubAppDTO{
+				Name:           "acme-deployer",
+				AppID:          123456,
+				InstallationID: 7890,
+				ClientID:       "Iv1.abc",
+				ClientSecret:   "client-secret",
+				WebhookSecret:  "webhook-secret",
+				PrivateKeyUUID: "fggkoowk084k8okc8wk4g4o4",
+			},
+		},
+		"NewKey": {
+			App: sdk.CreateGitHubAppDTO{
+				Name:           "acme-deployer",
+				AppID:          123456,
+				InstallationID: 7890,
+				ClientID:       "Iv1.abc",
+				ClientSecret:   "client-secret",
+				WebhookSecret:  "webhook-secret",
+			},
+			Key: &sdk.CreatePrivateKeyDTO{Name: "acme-deployer", PrivateKey: "github-app-key"},
+		},
+		"MissingKey": {
+			App: sdk.CreateGitHubAppDTO{
+				Name:           "acme-deployer",
+				AppID:          123456,
+				InstallationID: 7890,
+				ClientID:       "Iv1.abc",
+				ClientSecret:   "client-secret",
+				WebhookSecret:  "webhook-secret",
+			},
+			Error: true,
+		},
+		"UnknownKey": {
+			App: sdk.CreateGitHubAppDTO{
+				Name:           "acme-deployer",
+				AppID:          123456,
+				InstallationID: 7890,
+				ClientID:       "Iv1.abc",
+				ClientSecret:   "client-secret",
+				WebhookSecret:  "webhook-secret",
+				PrivateKeyUUID: "missing",
+			},
+			Error: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			setup(t)
+			ctx := context.Background()
+			coolify := sdk.Init(host, apiKey)
+
+			var app *sdk.GitHubApp
+			var errors error
+			if testComponent.Key != nil {
+				app, errors = coolify.GitHubApp.CreateWithKey(ctx, &testComponent.App, testComponent.Key)
+			} else {
+				app, errors = coolify.GitHubApp.Create(ctx, &testComponent.App)
+			}
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+			if testComponent.Error {
+				return
+			}
+
+			got, err := coolify.GitHubApp.Get(ctx, app.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.AppID != testComponent.App.AppID || got.HTMLURL != "https://github.com" || got.PrivateKeyID == 0 {
+				t.Errorf("got %+v", got)
+			}
+		})
+	}
+}
+
+func TestCreateGitHubAppWithKeyCleanup(t *testing.T) {
+	setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	_, err := coolify.GitHubApp.CreateWithKey(ctx, &sdk.CreateGitHubAppDTO{Name: "incomplete"}, &sdk.CreatePrivateKeyDTO{Name: "orphan", PrivateKey: "github-app-key"})
+	if err == nil {
+		t.Fatal("expected an error creating an incomplete app")
+	}
+
+	keys, err := coolify.PrivateKey.ListWithContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*keys) != 1 {
+		t.Errorf("got %d private keys, the key of the failed app should have been deleted", len(*keys))
+	}
+}
+
+func TestGitHubAppRepositories(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	id := fake.AddGitHubApp(sdk.GitHubApp{Name: "acme-deployer", AppID: 123456, InstallationID: 7890})
+	repo := sdk.GitHubRepository{Name: "shop", Private: true, DefaultBranch: "main"}
+	repo.Owner.Login = "acme"
+	fake.AddGitHubRepository(id, repo, "main", "develop")
+
+	repositories, err := coolify.GitHubApp.Repositories(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*repositories) != 1 || (*repositories)[0].FullName != "acme/shop" {
+		t.Fatalf("got %+v", *repositories)
+	}
+
+	branches, err := coolify.GitHubApp.Branches(ctx, id, "acme", "shop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*branches) != 2 || (*branches)[0].Name != "main" {
+		t.Errorf("got %+v", *branches)
+	}
+
+	if _, err := coolify.GitHubApp.Branches(ctx, id, "acme", "missing"); err == nil {
+		t.Error("expected an error for a repository the app cannot see")
+	}
+
+	if err := coolify.GitHubApp.Update(ctx, id, &sdk.UpdateGitHubAppDTO{Name: stringPtr("acme")}); err != nil {
+		t.Fatal(err)
+	}
+	apps, err := coolify.GitHubApp.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*apps) != 1 || (*apps)[0].Name != "acme" {
+		t.Errorf("got %+v", *apps)
+	}
+
+	if err := coolify.GitHubApp.Delete(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coolify.GitHubApp.Repositories(ctx, id); err == nil {
+		t.Error("expected an error listing repositories of a deleted app")
+	}
+}
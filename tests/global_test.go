@@ -1,8 +1,48 @@
-package coolify_sdk_test
-
-var host = "https://cloud.ferramenta.digital"
-var apiKey = "3|Tt6iybaozpfPZojdlK5gGGotoxK8Cn1u1xTUCe2T1e65744d"
-
-func stringPtr(s string) *string {
-	return &s
-}
+package coolify_sdk_test
+
+import (
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/coolifytest"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+var host string
+var apiKey string
+
+// setup starts a fake Coolify seeded with the fixtures used across the suite
+// and points host and apiKey at it.
+func setup(t *testing.T) *coolifytest.Server {
+	fake := coolifytest.NewServer()
+	t.Cleanup(fake.Close)
+
+	fake.AddTeam(sdk.Team{Id: 1, Name: "Test Team"}, sdk.Member{Id: 1, Name: "Test User", Email: "test@example.com"})
+
+	fake.AddPrivateKey(sdk.PrivateKey{UUID: "fggkoowk084k8okc8wk4g4o4", Name: "Test Key", PrivateKey: "test-private-key"})
+
+	fake.AddServer(server.Server{UUID: "ykwgwcg0cgk8owsk4gg8wwo4", Name: "Server 1", IP: "10.0.0.1", Port: 22, User: "root"})
+	fake.AddServer(server.Server{UUID: "lcs8ggw8cos48kw0sc0sk0gc", Name: "Server 2", IP: "10.0.0.2", Port: 22, User: "root"})
+	fake.AddServer(server.Server{UUID: "lo4sksgsks8kw8w0skog8c0s", Name: "Server 3", IP: "10.0.0.3", Port: 22, User: "root"})
+
+	fake.AddProject(sdk.Project{
+		UUID: "v8ckogcwgo0sgsogwooww84c",
+		Name: "Test Project",
+		Environments: []sdk.Environment{
+			{Name: "production"},
+			{Name: "dev"},
+		},
+	})
+
+	host = fake.URL
+	apiKey = fake.Token
+	return fake
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,128 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/coolifytest"
+)
+
+func TestHealth(t *testing.T) {
+	cases := map[string]struct {
+		Prepare func(t *testing.T, fake *coolifytest.Server) (host, token string)
+		Want    sdk.Health
+		Ready   bool
+		Error   bool
+	}{
+		"Healthy": {
+			Prepare: func(t *testing.T, fake *coolifytest.Server) (string, string) {
+				return fake.URL, fake.Token
+			},
+			Want:  sdk.Health{Reachable: true, Authenticated: true, APIEnabled: true},
+			Ready: true,
+		},
+		"InvalidToken": {
+			Prepare: func(t *testing.T, fake *coolifytest.Server) (string, string) {
+				return fake.URL, "wrong-token"
+			},
+			Want: sdk.Health{Reachable: true},
+		},
+		"APIDisabled": {
+			Prepare: func(t *testing.T, fake *coolifytest.Server) (string, string) {
+				if err := sdk.Init(fake.URL, fake.Token).Api.DisableWithContext(context.Background()); err != nil {
+					t.Fatal(err)
+				}
+				return fake.URL, fake.Token
+			},
+			Want: sdk.Health{Reachable: true, Authenticated: true},
+		},
+		"Unreachable": {
+			Prepare: func(t *testing.T, fake *coolifytest.Server) (string, string) {
+				fake.Close()
+				return fake.URL, fake.Token
+			},
+			Error: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fake := setup(t)
+			host, token := testComponent.Prepare(t, fake)
+
+			health, errors := sdk.Init(host, token).Health(context.Background())
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+
+			if health.Reachable != testComponent.Want.Reachable || health.Authenticated != testComponent.Want.Authenticated || health.APIEnabled != testComponent.Want.APIEnabled {
+				t.Errorf("got %+v, want %+v", health, testComponent.Want)
+			}
+			if health.Ready() != testComponent.Ready {
+				t.Errorf("Ready() = %v, want %v", health.Ready(), testComponent.Ready)
+			}
+			if testComponent.Ready {
+				if health.Team == nil || health.Team.Id != 0 {
+					t.Errorf("unexpected team %+v", health.Team)
+				}
+				if health.Version == nil || health.Version.String() != coolifytest.DefaultVersion {
+					t.Errorf("unexpected version %v", health.Version)
+				}
+				if health.Latency <= 0 {
+					t.Errorf("latency was not measured")
+				}
+			}
+		})
+	}
+}
+
+func TestHealthBypassesCache(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	cache := client.NewCache(client.CacheOptions{TTL: time.Minute})
+	coolify := sdk.Init(fake.URL, fake.Token, client.WithCache(cache), client.WithRetries(3, time.Millisecond))
+
+	health, err := coolify.Health(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !health.Ready() {
+		t.Fatalf("expected a ready instance, got %+v", health)
+	}
+
+	// An upgrade shows up right away.
+	fake.Version = "4.0.0-beta.421"
+	health, err = coolify.Health(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if health.Version == nil || health.Version.String() != fake.Version {
+		t.Errorf("got version %v after an upgrade, want %s", health.Version, fake.Version)
+	}
+
+	// Disable the API through another client, so that the cache is not
+	// purged.
+	if err := sdk.Init(fake.URL, fake.Token).Api.DisableWithContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+	health, err = coolify.Health(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if health.APIEnabled {
+		t.Error("Health answered from the cache")
+	}
+
+	fake.Inject(coolifytest.Fault{Path: "healthcheck", Status: http.StatusServiceUnavailable, Times: 1})
+	if _, err := coolify.Health(ctx); client.StatusCode(err) != http.StatusServiceUnavailable {
+		t.Errorf("expected the failed healthcheck not to be retried, got %v", err)
+	}
+}
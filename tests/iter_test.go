@@ -0,0 +1,160 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/database"
+)
+
+func TestIteratePages(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		if page == "" {
+			page = "1"
+		}
+		fmt.Fprintf(w, `{"data": [{"uuid": "a%[1]s"}, {"uuid": "b%[1]s"}], "current_page": %[1]s, "last_page": 3}`, page)
+	}))
+	defer server.Close()
+
+	c := client.NewClient(server.URL, "token")
+
+	var uuids []string
+	for key, err := range client.Iterate[sdk.PrivateKey](context.Background(), c, "security/keys", nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		uuids = append(uuids, key.UUID)
+	}
+
+	if !slices.Equal(uuids, []string{"a1", "b1", "a2", "b2", "a3", "b3"}) {
+		t.Errorf("unexpected items %v", uuids)
+	}
+	if !slices.Equal(pages, []string{"", "2", "3"}) {
+		t.Errorf("unexpected pages requested %v", pages)
+	}
+
+	// Breaking out of the loop stops paging.
+	pages = nil
+	for range client.Iterate[sdk.PrivateKey](context.Background(), c, "security/keys", nil) {
+		break
+	}
+	if len(pages) != 1 {
+		t.Errorf("expected a single request, got %v", pages)
+	}
+}
+
+func TestDatabaseAll(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+
+	projectUUID := fake.AddProject(sdk.Project{Name: "Shop", Environments: []sdk.Environment{{Name: "production"}, {Name: "staging"}}})
+	environments := map[string]int{}
+	for _, name := range []string{"production", "staging"} {
+		environment, err := coolify.Project.EnvironmentWithContext(ctx, projectUUID, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		environments[name] = int(environment.Id)
+	}
+
+	fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "orders", EnvironmentID: environments["production"], Status: "running:healthy", Tags: []database.Tag{{Name: "critical"}}})
+	fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "orders-staging", EnvironmentID: environments["staging"], Status: "exited"})
+	fake.AddDatabase("lcs8ggw8cos48kw0sc0sk0gc", database.Database{Name: "cache", EnvironmentID: environments["production"], Status: "running:unhealthy"})
+
+	cases := map[string]struct {
+		Filter database.Filter
+		Names  []string
+	}{
+		"Everything": {
+			Names: []string{"cache", "orders", "orders-staging"},
+		},
+		"Project": {
+			Filter: database.Filter{ProjectUUID: projectUUID},
+			Names:  []string{"cache", "orders", "orders-staging"},
+		},
+		"Environment": {
+			Filter: database.Filter{Environment: "staging"},
+			Names:  []string{"orders-staging"},
+		},
+		"Server": {
+			Filter: database.Filter{ServerUUID: "lcs8ggw8cos48kw0sc0sk0gc"},
+			Names:  []string{"cache"},
+		},
+		"State": {
+			Filter: database.Filter{Status: "running"},
+			Names:  []string{"cache", "orders"},
+		},
+		"FullStatus": {
+			Filter: database.Filter{Status: "running:healthy"},
+			Names:  []string{"orders"},
+		},
+		"Tag": {
+			Filter: database.Filter{Tag: "critical"},
+			Names:  []string{"orders"},
+		},
+		"Combined": {
+			Filter: database.Filter{ProjectUUID: projectUUID, Environment: "production", ServerUUID: "ykwgwcg0cgk8owsk4gg8wwo4"},
+			Names:  []string{"orders"},
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var names []string
+			for db, err := range coolify.Database.All(ctx, testComponent.Filter) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				names = append(names, db.Name)
+			}
+			slices.Sort(names)
+
+			if !slices.Equal(names, testComponent.Names) {
+				t.Errorf("got %v, want %v", names, testComponent.Names)
+			}
+		})
+	}
+}
+
+func TestAllIterators(t *testing.T) {
+	setup(t)
+	coolify := sdk.Init(host, apiKey)
+	ctx := context.Background()
+
+	servers := 0
+	for _, err := range coolify.Server.All(ctx) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		servers++
+	}
+	if servers != 3 {
+		t.Errorf("got %d servers, want 3", servers)
+	}
+
+	for project, err := range coolify.Project.All(ctx) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if project.UUID != "v8ckogcwgo0sgsogwooww84c" {
+			t.Errorf("unexpected project %s", project.UUID)
+		}
+	}
+
+	unauthorized := sdk.Init(host, "wrong-token")
+	for _, err := range unauthorized.PrivateKey.All(ctx) {
+		if err == nil {
+			t.Error("expected an error with an invalid token")
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/coolifytest"
+	"github.com/marconneves/coolify-sdk-go/manifest"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+const testManifest = `private_keys:
+  - name: Test Key
+    private_key: test-private-key
+  - name: Deploy Key
+    description: CI deploys
+    private_key: deploy-private-key
+servers:
+  - name: Server 1
+    description: Primary
+    ip: 10.0.0.1
+    private_key: Test Key
+  - name: Server 4
+    ip: 10.0.0.4
+    private_key: Deploy Key
+projects:
+  - name: Test Project
+    environments: [production, dev]
+  - name: Shop
+databases:
+  - name: orders
+    type: postgresql
+    project: Shop
+    server: Server 4
+    limits_memory: 512m
+`
+
+func TestManifestPlanApply(t *testing.T) {
+	setup(t)
+	ctx := context.Background()
+	engine := manifest.NewEngine(sdk.Init(host, apiKey))
+
+	m, err := manifest.Parse([]byte(testManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := engine.Plan(ctx, m, manifest.PlanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]manifest.Action{
+		"Test Key":                manifest.ActionNoop,
+		"Deploy Key":              manifest.ActionCreate,
+		"Server 1":                manifest.ActionUpdate,
+		"Server 4":                manifest.ActionCreate,
+		"Test Project":            manifest.ActionNoop,
+		"Shop":                    manifest.ActionCreate,
+		"Test Project/production": manifest.ActionNoop,
+		"Test Project/dev":        manifest.ActionNoop,
+		"Shop/production":         manifest.ActionNoop,
+		"Shop/production/orders":  manifest.ActionCreate,
+	}
+	if len(plan.Changes) != len(want) {
+		t.Fatalf("got %d changes, want %d:\n%s", len(plan.Changes), len(want), plan)
+	}
+	for _, change := range plan.Changes {
+		if change.Action != want[change.Name] {
+			t.Errorf("%s %s: got %s, want %s", change.Kind, change.Name, change.Action, want[change.Name])
+		}
+		if change.Name == "Server 1" && (len(change.Diffs) == 0 || change.Diffs[0].Field != "description") {
+			t.Errorf("Server 1: unexpected diffs %+v", change.Diffs)
+		}
+	}
+
+	if err := engine.Apply(ctx, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err = engine.Plan(ctx, m, manifest.PlanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.HasChanges() {
+		t.Errorf("expected no changes after apply:\n%s", plan)
+	}
+}
+
+func TestManifestPrune(t *testing.T) {
+	fake := setup(t)
+	fake.AddPrivateKey(sdk.PrivateKey{ID: 900, Name: "localhost's key", PrivateKey: "localhost-private-key"})
+	fake.AddServer(server.Server{Name: "localhost", IP: "host.docker.internal", Port: 22, User: "root", PrivateKeyID: 900})
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+	engine := manifest.NewEngine(coolify)
+
+	m, err := manifest.Parse([]byte(`
+private_keys:
+  - name: Test Key
+    private_key: test-private-key
+servers:
+  - name: Server 1
+    ip: 10.0.0.1
+    private_key: Test Key
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := engine.Plan(ctx, m, manifest.PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Apply(ctx, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	servers, err := coolify.Server.ListWithContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, srv := range *servers {
+		names = append(names, srv.Name)
+	}
+	slices.Sort(names)
+	if !slices.Equal(names, []string{"Server 1", "localhost"}) {
+		t.Errorf("unexpected servers after prune: %v", names)
+	}
+
+	keys, err := coolify.PrivateKey.ListWithContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*keys) != 2 {
+		t.Errorf("expected the localhost key to be kept, got %d keys", len(*keys))
+	}
+
+	projects, err := coolify.Project.ListWithContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*projects) != 0 {
+		t.Errorf("expected projects to be pruned, got %d", len(*projects))
+	}
+}
+
+func TestManifestPruneKeepsReferences(t *testing.T) {
+	cases := map[string]struct {
+		Seed     func(fake *coolifytest.Server)
+		Manifest string
+		Kept     []string
+	}{
+		"DatabaseServerAndProject": {
+			Manifest: `
+databases:
+  - name: orders
+    type: postgresql
+    project: Test Project
+    server: Server 1
+`,
+			Kept: []string{"Server 1", "Test Project"},
+		},
+		"ExistingServerKey": {
+			Manifest: `
+servers:
+  - name: Server 1
+    ip: 10.0.0.1
+    private_key: Test Key
+`,
+			Kept: []string{"Test Key"},
+		},
+		"CreatedServerKey": {
+			Manifest: `
+servers:
+  - name: Server 9
+    ip: 10.0.0.9
+    private_key: Test Key
+`,
+			Kept: []string{"Test Key"},
+		},
+		"SwitchedServerKey": {
+			Seed: func(fake *coolifytest.Server) {
+				fake.AddPrivateKey(sdk.PrivateKey{ID: 901, Name: "Old Key", PrivateKey: "old-private-key"})
+				fake.AddServer(server.Server{Name: "Server 5", IP: "10.0.0.5", Port: 22, User: "root", PrivateKeyID: 901})
+			},
+			Manifest: `
+servers:
+  - name: Server 5
+    ip: 10.0.0.5
+    private_key: Test Key
+`,
+			Kept: []string{"Server 5", "Old Key", "Test Key"},
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fake := setup(t)
+			if testComponent.Seed != nil {
+				testComponent.Seed(fake)
+			}
+			engine := manifest.NewEngine(sdk.Init(host, apiKey))
+
+			m, err := manifest.Parse([]byte(testComponent.Manifest))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			plan, errors := engine.Plan(context.Background(), m, manifest.PlanOptions{Prune: true})
+			if errors != nil {
+				t.Fatalf("Plan failed: %v", errors)
+			}
+
+			for _, change := range plan.Changes {
+				if change.Action == manifest.ActionDelete && slices.Contains(testComponent.Kept, change.Name) {
+					t.Errorf("%s %s is referenced by the manifest but would be deleted", change.Kind, change.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestManifestDetectDrift(t *testing.T) {
+	setup(t)
+	ctx := context.Background()
+	engine := manifest.NewEngine(sdk.Init(host, apiKey))
+
+	m, err := manifest.Parse([]byte(testManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := engine.DetectDrift(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Changed) != 1 || report.Changed[0].Name != "Server 1" {
+		t.Fatalf("unexpected changes %+v", report.Changed)
+	}
+	if field := report.Changed[0].Fields[0]; field.Path != "Server.description" || field.Baseline != "Primary" {
+		t.Errorf("unexpected field drift %+v", field)
+	}
+
+	removed := []string{}
+	for _, resource := range report.Removed {
+		removed = append(removed, resource.Kind+" "+resource.Name)
+	}
+	slices.Sort(removed)
+	want := []string{"Database Shop/production/orders", "PrivateKey Deploy Key", "Project Shop", "Server Server 4"}
+	if !slices.Equal(removed, want) {
+		t.Errorf("got removed %v, want %v", removed, want)
+	}
+
+	added := []string{}
+	for _, resource := range report.Added {
+		added = append(added, resource.Name)
+	}
+	slices.Sort(added)
+	if !slices.Equal(added, []string{"Server 2", "Server 3"}) {
+		t.Errorf("got added %v, want the undeclared servers", added)
+	}
+
+	plan, err := engine.Plan(ctx, m, manifest.PlanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Apply(ctx, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = engine.DetectDrift(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Changed) > 0 || len(report.Removed) > 0 {
+		t.Errorf("expected no drift after apply, got %+v", report)
+	}
+}
+
+func TestManifestUnsupportedEnvironment(t *testing.T) {
+	setup(t)
+	engine := manifest.NewEngine(sdk.Init(host, apiKey))
+
+	m, err := manifest.Parse([]byte(`
+projects:
+  - name: Shop
+    environments: [staging]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := engine.Plan(context.Background(), m, manifest.PlanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = engine.Apply(context.Background(), plan)
+	if !errors.Is(err, manifest.ErrUnsupported) {
+		t.Fatalf("expected ErrUnsupported, got %v", err)
+	}
+
+	projects, err := sdk.Init(host, apiKey).Project.ListWithContext(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*projects) != 1 {
+		t.Errorf("Apply changed the instance before failing")
+	}
+}
+
+func TestManifestParse(t *testing.T) {
+	cases := map[string]struct {
+		Manifest string
+		Error    bool
+	}{
+		"Valid": {
+			Manifest: `{"projects": [{"name": "Shop"}]}`,
+		},
+		"DuplicateServer": {
+			Manifest: "servers:\n  - {name: a, ip: 10.0.0.1, private_key: k}\n  - {name: a, ip: 10.0.0.2, private_key: k}\n",
+			Error:    true,
+		},
+		"KeyWithoutMaterial": {
+			Manifest: "private_keys:\n  - name: k\n",
+			Error:    true,
+		},
+		"UnknownDatabaseType": {
+			Manifest: "databases:\n  - {name: d, type: mongodb, project: p, server: s}\n",
+			Error:    true,
+		},
+		"InvalidYAML": {
+			Manifest: "projects: [",
+			Error:    true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			_, errors := manifest.Parse([]byte(testComponent.Manifest))
+
+			if errors != nil && !testComponent.Error {
+				t.Errorf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
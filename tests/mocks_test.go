@@ -0,0 +1,52 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/mocks"
+	"github.com/marconneves/coolify-sdk-go/server"
+	"github.com/marconneves/coolify-sdk-go/storage"
+)
+
+func TestMockedServerAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	servers := mocks.NewMockServerAPI(ctrl)
+	servers.EXPECT().List().Return(&[]server.Server{{UUID: "srv1", Name: "primary"}}, nil)
+	servers.EXPECT().Validate("srv1").Return(errors.New("unreachable"))
+
+	var client = sdk.Init(host, apiKey)
+	client.Server = servers
+
+	list, err := client.Server.List()
+	if err != nil || len(*list) != 1 || (*list)[0].UUID != "srv1" {
+		t.Fatalf("unexpected scripted response: %v, %v", list, err)
+	}
+
+	if err := client.Server.Validate("srv1"); err == nil {
+		t.Errorf("expected the scripted error")
+	}
+}
+
+func TestMockedDatabaseStorages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	storages := mocks.NewMockStorageAPI(ctrl)
+	storages.EXPECT().List(gomock.Any()).Return(&[]storage.Storage{{UUID: "st1", Name: "data"}}, nil)
+
+	databases := mocks.NewMockDatabaseAPI(ctrl)
+	databases.EXPECT().Storages("db1").Return(storages)
+
+	var client = sdk.Init(host, apiKey)
+	client.Database = databases
+
+	list, err := client.Database.Storages("db1").List(context.Background())
+	if err != nil || len(*list) != 1 || (*list)[0].UUID != "st1" {
+		t.Fatalf("unexpected scripted response: %v, %v", list, err)
+	}
+}
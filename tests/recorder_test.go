@@ -0,0 +1,139 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/recorder"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	fake := setup(t)
+	cassette := filepath.Join(t.TempDir(), "databases.json")
+	ctx := context.Background()
+	serverUUID := fake.AddServer(server.Server{Name: "logged", IP: "10.0.0.9", Settings: &server.Settings{
+		LogdrainAxiomApiKey:        stringPtr("axiom-api-key"),
+		LogdrainNewRelicLicenseKey: stringPtr("newrelic-license-key"),
+		MetricsToken:               "metrics-token",
+	}})
+
+	rec, err := recorder.New(cassette, recorder.ModeRecord)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	var recording = sdk.Init(host, apiKey, client.WithTransport(rec))
+	uuid, err := recording.Database.CreatePostgreSQL(ctx, &sdk.CreateDatabasePostgresDTO{
+		ServerUUID:       "ykwgwcg0cgk8owsk4gg8wwo4",
+		ProjectUUID:      "v8ckogcwgo0sgsogwooww84c",
+		Environment:      "production",
+		PostgresPassword: stringPtr("hunter2"),
+	})
+	if err != nil {
+		t.Fatalf("CreatePostgreSQL failed: %v", err)
+	}
+	if _, err := recording.Database.Get(ctx, *uuid); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if _, err := recording.Server.GetWithContext(ctx, serverUUID); err != nil {
+		t.Fatalf("Get server failed: %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop failed: %v", err)
+	}
+
+	data, err := os.ReadFile(cassette)
+	if err != nil {
+		t.Fatalf("cassette not written: %v", err)
+	}
+	for _, secret := range []string{apiKey, "hunter2", "axiom-api-key", "newrelic-license-key", "metrics-token"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette leaks %q", secret)
+		}
+	}
+
+	fake.Close()
+
+	replay, err := recorder.New(cassette, recorder.ModeReplay)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	var replaying = sdk.Init(host, "another-token", client.WithTransport(replay))
+	replayedUUID, err := replaying.Database.CreatePostgreSQL(ctx, &sdk.CreateDatabasePostgresDTO{
+		ProjectUUID:      "v8ckogcwgo0sgsogwooww84c",
+		ServerUUID:       "ykwgwcg0cgk8owsk4gg8wwo4",
+		Environment:      "production",
+		PostgresPassword: stringPtr("a-different-secret"),
+	})
+	if err != nil || *replayedUUID != *uuid {
+		t.Fatalf("replay returned %v, %v", replayedUUID, err)
+	}
+	db, err := replaying.Database.Get(ctx, *uuid)
+	if err != nil || db.PostgresPassword != recorder.Redacted {
+		t.Fatalf("replay returned %+v, %v", db, err)
+	}
+
+	if _, err := replaying.Database.List(ctx); err == nil {
+		t.Errorf("expected an error for an unrecorded request")
+	}
+	if _, err := replaying.Server.GetWithContext(ctx, serverUUID); err != nil {
+		t.Fatalf("replay of the server failed: %v", err)
+	}
+	if !strings.Contains(db.InternalDbURL, recorder.Redacted+"@") {
+		t.Errorf("internal_db_url was not scrubbed: %s", db.InternalDbURL)
+	}
+	if unused := replay.Unused(); len(unused) != 0 {
+		t.Errorf("expected every interaction to be replayed, %d left", len(unused))
+	}
+}
+
+func TestReplayServerCassette(t *testing.T) {
+	rec, err := recorder.New("testdata/servers.json", recorder.ModeReplay)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	var client = sdk.Init("https://coolify.invalid", "token", client.WithTransport(rec))
+
+	servers, err := client.Server.List()
+	if err != nil || len(*servers) != 1 {
+		t.Fatalf("List returned %v, %v", servers, err)
+	}
+
+	cases := map[string]struct {
+		UUID  string
+		Error bool
+	}{
+		"ValidRequest": {
+			UUID:  "ykwgwcg0cgk8owsk4gg8wwo4",
+			Error: false,
+		},
+		"WithInvalidId": {
+			UUID:  "missing",
+			Error: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			_, err := client.Server.Get(testComponent.UUID)
+
+			if err != nil && !testComponent.Error {
+				t.Errorf("Server retrieval failed unexpectedly: %v", err)
+			} else if err == nil && testComponent.Error {
+				t.Errorf("Server retrieval succeeded unexpectedly")
+			}
+		})
+	}
+
+	if err := client.Server.Validate("ykwgwcg0cgk8owsk4gg8wwo4"); err != nil {
+		t.Errorf("Validate failed: %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package coolify_sdk_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/coolifytest"
+	"github.com/marconneves/coolify-sdk-go/storage"
+)
+
+func TestCreateS3Storage(t *testing.T) {
+	cases := map[string]struct {
+		Data  storage.CreateS3StorageDTO
+		Error bool
+	}{
+		"Valid": {
+			Data: storage.CreateS3StorageDTO{
+				Name:     "backups",
+				Endpoint: "https://s3.eu-west-1.amazonaws.com",
+				Bucket:   "coolify-backups",
+				Region:   "eu-west-1",
+				Key:      "AKIAEXAMPLE",
+				Secret:   "s3-secret",
+			},
+		},
+		"MissingBucket": {
+			Data: storage.CreateS3StorageDTO{
+				Name:     "backups",
+				Endpoint: "https://s3.eu-west-1.amazonaws.com",
+				Region:   "eu-west-1",
+				Key:      "AKIAEXAMPLE",
+				Secret:   "s3-secret",
+			},
+			Error: true,
+		},
+		"MissingSecret": {
+			Data: storage.CreateS3StorageDTO{
+				Name:     "backups",
+				Endpoint: "https://s3.eu-west-1.amazonaws.com",
+				Bucket:   "coolify-backups",
+				Region:   "eu-west-1",
+				Key:      "AKIAEXAMPLE",
+			},
+			Error: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			setup(t)
+			ctx := context.Background()
+			coolify := sdk.Init(host, apiKey)
+
+			uuid, errors := coolify.S3Storage.Create(ctx, &testComponent.Data)
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+			if testComponent.Error {
+				return
+			}
+
+			got, err := coolify.S3Storage.Get(ctx, *uuid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Bucket != testComponent.Data.Bucket || got.Secret != testComponent.Data.Secret {
+				t.Errorf("got %+v, want %+v", got, testComponent.Data)
+			}
+		})
+	}
+}
+
+func TestS3StorageLifecycle(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+	uuid := fake.AddS3Storage(storage.S3Storage{Name: "backups", Endpoint: "https://minio.local", Bucket: "db", Region: "us-east-1", Key: "minio", Secret: "minio-secret"})
+
+	if err := coolify.S3Storage.Test(ctx, uuid); err != nil {
+		t.Fatal(err)
+	}
+	got, err := coolify.S3Storage.Get(ctx, uuid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.IsUsable {
+		t.Error("storage should be usable after a successful test")
+	}
+
+	if err := coolify.S3Storage.Update(ctx, uuid, &storage.UpdateS3StorageDTO{Bucket: stringPtr("db-backups")}); err != nil {
+		t.Fatal(err)
+	}
+	fake.Inject(coolifytest.Fault{Path: "storages/" + uuid + "/test", Status: http.StatusBadRequest, Times: 1})
+	if err := coolify.S3Storage.Test(ctx, uuid); err == nil {
+		t.Error("expected an error for an unreachable bucket")
+	}
+
+	storages, err := coolify.S3Storage.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*storages) != 1 || (*storages)[0].Bucket != "db-backups" {
+		t.Errorf("got %+v", *storages)
+	}
+
+	if err := coolify.S3Storage.Delete(ctx, uuid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coolify.S3Storage.Get(ctx, uuid); err == nil {
+		t.Error("expected an error getting a deleted storage")
+	}
+}
+
+func TestS3StorageRedacted(t *testing.T) {
+	st := storage.S3Storage{UUID: "s3", Bucket: "db", Key: "AKIAEXAMPLE", Secret: "s3-secret"}
+
+	var logs bytes.Buffer
+	slog.New(slog.NewJSONHandler(&logs, nil)).Info("storage", "storage", st)
+
+	cases := map[string]string{
+		"String":   fmt.Sprint(st),
+		"Plus":     fmt.Sprintf("%+v", st),
+		"GoString": fmt.Sprintf("%#v", st),
+		"Pointer":  fmt.Sprintf("%v", &st),
+		"Slog":     logs.String(),
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if strings.Contains(testComponent, "AKIAEXAMPLE") || strings.Contains(testComponent, "s3-secret") {
+				t.Errorf("credentials leaked: %s", testComponent)
+			}
+			if !strings.Contains(testComponent, storage.Redacted) || !strings.Contains(testComponent, "db") {
+				t.Errorf("unexpected output: %s", testComponent)
+			}
+		})
+	}
+
+	if st.Secret != "s3-secret" {
+		t.Error("redacting must not modify the storage")
+	}
+}
+
+func TestS3StorageDTORedacted(t *testing.T) {
+	cases := map[string]any{
+		"Create": storage.CreateS3StorageDTO{Name: "backups", Bucket: "db", Key: "AKIAEXAMPLE", Secret: "s3-secret"},
+		"Update": storage.UpdateS3StorageDTO{Bucket: stringPtr("db"), Key: stringPtr("AKIAEXAMPLE"), Secret: stringPtr("s3-secret")},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var logs bytes.Buffer
+			slog.New(slog.NewJSONHandler(&logs, nil)).Info("storage", "storage", testComponent)
+
+			outputs := map[string]string{
+				"String":   fmt.Sprint(testComponent),
+				"Plus":     fmt.Sprintf("%+v", testComponent),
+				"GoString": fmt.Sprintf("%#v", testComponent),
+				"Slog":     logs.String(),
+			}
+			for format, output := range outputs {
+				if strings.Contains(output, "AKIAEXAMPLE") || strings.Contains(output, "s3-secret") {
+					t.Errorf("%s: credentials leaked: %s", format, output)
+				}
+				if !strings.Contains(output, storage.Redacted) || !strings.Contains(output, "db") {
+					t.Errorf("%s: unexpected output: %s", format, output)
+				}
+			}
+		})
+	}
+}
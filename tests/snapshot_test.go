@@ -0,0 +1,182 @@
+package coolify_sdk_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/coolifytest"
+	"github.com/marconneves/coolify-sdk-go/database"
+	"github.com/marconneves/coolify-sdk-go/server"
+)
+
+func TestExport(t *testing.T) {
+	fake := setup(t)
+	fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "orders", DatabaseType: "standalone-postgresql", PostgresPassword: "hunter2"})
+	logged := fake.AddServer(server.Server{Name: "Server 4", IP: "10.0.0.4", Settings: &server.Settings{
+		LogdrainAxiomApiKey:        stringPtr("axiom-api-key"),
+		LogdrainNewRelicLicenseKey: stringPtr("newrelic-license-key"),
+		MetricsToken:               "metrics-token",
+	}})
+	fake.AddDatabase(logged, database.Database{Name: "sessions", DatabaseType: "standalone-redis"})
+
+	cases := map[string]struct {
+		Options sdk.ExportOptions
+		Secrets bool
+	}{
+		"MetadataOnly": {},
+		"WithSecrets": {
+			Options: sdk.ExportOptions{IncludeSecrets: true},
+			Secrets: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			coolify := sdk.Init(host, apiKey)
+
+			snapshot, err := coolify.Export(context.Background(), testComponent.Options)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if snapshot.Version != sdk.SnapshotVersion {
+				t.Errorf("got version %d", snapshot.Version)
+			}
+			if len(snapshot.Servers) != 4 || snapshot.Servers[0].Name != "Server 1" || snapshot.Servers[3].Name != "Server 4" {
+				t.Errorf("servers are not sorted by name: %+v", snapshot.Servers)
+			}
+			if snapshot.Servers[0].Settings == nil {
+				t.Errorf("server settings were not exported")
+			}
+			if len(snapshot.Teams) == 0 || len(snapshot.Teams[len(snapshot.Teams)-1].Members) != 1 {
+				t.Errorf("team members were not exported: %+v", snapshot.Teams)
+			}
+			if len(snapshot.Projects) != 1 || len(snapshot.Projects[0].Environments) != 2 {
+				t.Errorf("project environments were not exported: %+v", snapshot.Projects)
+			}
+
+			hasSecrets := snapshot.PrivateKeys[0].PrivateKey != "" && snapshot.Databases[0].PostgresPassword != ""
+			if hasSecrets != testComponent.Secrets {
+				t.Errorf("got secrets %v, want %v", hasSecrets, testComponent.Secrets)
+			}
+
+			var first, second bytes.Buffer
+			if err := snapshot.Write(&first); err != nil {
+				t.Fatal(err)
+			}
+			read, err := sdk.ReadSnapshot(&first)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := read.Write(&second); err != nil {
+				t.Fatal(err)
+			}
+			first.Reset()
+			snapshot.Write(&first)
+			if first.String() != second.String() {
+				t.Errorf("snapshot changed after a write/read round trip")
+			}
+			for _, secret := range []string{"axiom-api-key", "newrelic-license-key", "metrics-token"} {
+				if strings.Contains(first.String(), secret) != testComponent.Secrets {
+					t.Errorf("got %q in the snapshot: %v, want %v", secret, !testComponent.Secrets, testComponent.Secrets)
+				}
+			}
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	source := setup(t)
+	production, err := sdk.Init(host, apiKey).Project.EnvironmentWithContext(context.Background(), "v8ckogcwgo0sgsogwooww84c", "production")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "orders", DatabaseType: "standalone-postgresql", EnvironmentID: int(production.Id)})
+
+	snapshot, err := sdk.Init(host, apiKey).Export(context.Background(), sdk.ExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	target := coolifytest.NewServer()
+	t.Cleanup(target.Close)
+	coolify := sdk.Init(target.URL, target.Token)
+
+	// The seeded servers have no private key, so point them at the
+	// exported one.
+	for i := range snapshot.Servers {
+		snapshot.Servers[i].PrivateKeyID = snapshot.PrivateKeys[0].ID
+	}
+
+	if _, err := coolify.Import(context.Background(), snapshot, sdk.ImportOptions{}); err == nil {
+		t.Fatal("expected an error for private keys without material")
+	}
+
+	// Servers are reused by IP, so give the target one of the snapshot's.
+	existing := target.AddServer(sdk.Server{Name: "already there", IP: "10.0.0.2"})
+
+	result, err := coolify.Import(context.Background(), snapshot, sdk.ImportOptions{
+		PrivateKeys: map[string]string{"fggkoowk084k8okc8wk4g4o4": "test-private-key"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.UUIDs["lcs8ggw8cos48kw0sc0sk0gc"] != existing {
+		t.Errorf("server with an existing IP was not reused")
+	}
+	if len(result.Skipped) == 0 {
+		t.Errorf("expected teams and the dev environment to be skipped")
+	}
+
+	dbUUID := result.UUIDs[snapshot.Databases[0].UUID]
+	db, err := coolify.Database.Get(context.Background(), dbUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.Destination.Server.UUID != result.UUIDs["ykwgwcg0cgk8owsk4gg8wwo4"] {
+		t.Errorf("database server was not remapped: got %s", db.Destination.Server.UUID)
+	}
+
+	projects, err := coolify.Project.ListWithContext(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*projects) != 1 || (*projects)[0].UUID != result.UUIDs["v8ckogcwgo0sgsogwooww84c"] {
+		t.Errorf("project was not imported: %+v", *projects)
+	}
+}
+
+func TestImportReusedServersNeedNoKeys(t *testing.T) {
+	setup(t)
+	coolify := sdk.Init(host, apiKey)
+
+	snapshot, err := coolify.Export(context.Background(), sdk.ExportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range snapshot.Servers {
+		snapshot.Servers[i].PrivateKeyID = snapshot.PrivateKeys[0].ID
+	}
+
+	// Every server already exists by IP, so the key is neither needed nor
+	// created, even without its material.
+	result, err := coolify.Import(context.Background(), snapshot, sdk.ImportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := result.UUIDs["fggkoowk084k8okc8wk4g4o4"]; ok {
+		t.Error("unused private key was imported")
+	}
+	keys, err := coolify.PrivateKey.ListWithContext(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*keys) != 1 {
+		t.Errorf("got %d private keys, want 1", len(*keys))
+	}
+}
@@ -0,0 +1,219 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/database"
+)
+
+func TestParseStatus(t *testing.T) {
+	cases := map[string]struct {
+		Status  string
+		Want    database.Status
+		Healthy bool
+	}{
+		"RunningHealthy": {
+			Status:  "running:healthy",
+			Want:    database.Status{State: database.StateRunning, Health: database.HealthHealthy},
+			Healthy: true,
+		},
+		"ExitedUnhealthy": {
+			Status: "exited:unhealthy",
+			Want:   database.Status{State: database.StateExited, Health: database.HealthUnhealthy},
+		},
+		"StateOnly": {
+			Status: "exited",
+			Want:   database.Status{State: database.StateExited},
+		},
+		"Empty": {},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			status := database.ParseStatus(testComponent.Status)
+
+			if status != testComponent.Want {
+				t.Errorf("got %+v, want %+v", status, testComponent.Want)
+			}
+			if status.String() != testComponent.Status {
+				t.Errorf("formatted as %q, want %q", status, testComponent.Status)
+			}
+			if status.Matches(database.StatusHealthy) != testComponent.Healthy {
+				t.Errorf("Matches(StatusHealthy) = %v", !testComponent.Healthy)
+			}
+		})
+	}
+
+	if !database.ParseStatus("exited:unhealthy").Matches(database.StatusExited) {
+		t.Error("an empty desired health should match any health")
+	}
+}
+
+func TestWaitForStatus(t *testing.T) {
+	statuses := []string{"exited", "starting:unknown", "running:unhealthy", "running:healthy"}
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+		w.Write([]byte(`{"uuid": "db", "status": "` + status + `"}`))
+	}))
+	defer server.Close()
+
+	coolify := sdk.Init(server.URL, apiKey)
+	err := coolify.Database.WaitForStatus(context.Background(), "db", database.StatusHealthy, database.WaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if polls != len(statuses) {
+		t.Errorf("got %d polls, want %d", polls, len(statuses))
+	}
+}
+
+func TestRestartAndWait(t *testing.T) {
+	const before = `"status": "running:healthy", "started_at": "2024-01-01T00:00:00.000000Z"`
+	const after = `"status": "running:healthy", "started_at": "2024-01-01T00:05:00.000000Z"`
+
+	cases := map[string]struct {
+		Polls []string
+	}{
+		"StatusLeavesHealthy": {
+			Polls: []string{before, before, `"status": "restarting:unknown"`, `"status": "running:unhealthy"`, before},
+		},
+		"StartedAtMoves": {
+			Polls: []string{before, before, before, after},
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			polls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/v1/databases/db/restart" {
+					w.Write([]byte(`{"message": "Database restarting request queued."}`))
+					return
+				}
+				fields := testComponent.Polls[min(polls, len(testComponent.Polls)-1)]
+				polls++
+				w.Write([]byte(`{"uuid": "db", ` + fields + `}`))
+			}))
+			defer server.Close()
+
+			coolify := sdk.Init(server.URL, apiKey)
+			err := coolify.Database.RestartAndWait(context.Background(), "db", database.WaitOptions{Interval: time.Millisecond, Timeout: time.Second})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if polls != len(testComponent.Polls) {
+				t.Errorf("got %d polls, want %d", polls, len(testComponent.Polls))
+			}
+		})
+	}
+}
+
+func TestStartAndWait(t *testing.T) {
+	cases := map[string]struct {
+		Statuses []string
+		Options  []client.Option
+	}{
+		"WithoutHealthcheck": {
+			Statuses: []string{"exited", "starting", "running:unknown"},
+		},
+		"CachedClient": {
+			Statuses: []string{"exited", "exited", "running:healthy"},
+			Options:  []client.Option{client.WithCache(client.NewCache(client.CacheOptions{TTL: time.Minute}))},
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			polls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/api/v1/databases/db/start" {
+					w.Write([]byte(`{"message": "Database starting request queued."}`))
+					return
+				}
+				status := testComponent.Statuses[min(polls, len(testComponent.Statuses)-1)]
+				polls++
+				w.Write([]byte(`{"uuid": "db", "status": "` + status + `"}`))
+			}))
+			defer server.Close()
+
+			coolify := sdk.Init(server.URL, apiKey, testComponent.Options...)
+			err := coolify.Database.StartAndWait(context.Background(), "db", database.WaitOptions{Interval: time.Millisecond, Timeout: time.Second})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if polls != len(testComponent.Statuses) {
+				t.Errorf("got %d polls, want %d", polls, len(testComponent.Statuses))
+			}
+		})
+	}
+}
+
+func TestDatabaseAndWait(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+	opts := database.WaitOptions{Interval: time.Millisecond, Timeout: 50 * time.Millisecond}
+
+	uuid := fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", database.Database{Name: "orders", Status: "exited"})
+
+	cases := map[string]struct {
+		Action func() error
+		Status string
+		Error  error
+	}{
+		"Timeout": {
+			Action: func() error {
+				return coolify.Database.WaitForStatus(ctx, uuid, database.StatusHealthy, opts)
+			},
+			Status: "exited",
+			Error:  database.ErrWaitTimeout,
+		},
+		"Start": {
+			Action: func() error { return coolify.Database.StartAndWait(ctx, uuid, opts) },
+			Status: "running:healthy",
+		},
+		"Restart": {
+			Action: func() error { return coolify.Database.RestartAndWait(ctx, uuid, opts) },
+			Status: "running:healthy",
+		},
+		"Stop": {
+			Action: func() error { return coolify.Database.StopAndWait(ctx, uuid, opts) },
+			Status: "exited",
+		},
+	}
+
+	for _, testName := range []string{"Timeout", "Start", "Restart", "Stop"} {
+		testComponent := cases[testName]
+		t.Run(testName, func(t *testing.T) {
+			errors := testComponent.Action()
+
+			if !isError(errors, testComponent.Error) {
+				t.Fatalf("got error %v, want %v", errors, testComponent.Error)
+			}
+
+			db, err := coolify.Database.Get(ctx, uuid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if db.Status != testComponent.Status {
+				t.Errorf("got status %s, want %s", db.Status, testComponent.Status)
+			}
+		})
+	}
+}
+
+func isError(err, target error) bool {
+	if target == nil {
+		return err == nil
+	}
+	return errors.Is(err, target)
+}
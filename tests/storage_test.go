@@ -0,0 +1,138 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/storage"
+)
+
+func TestCreateStorage(t *testing.T) {
+	cases := map[string]struct {
+		Data  storage.CreateStorageDTO
+		Error bool
+	}{
+		"Volume": {
+			Data: storage.CreateStorageDTO{
+				Type:      storage.Persistent,
+				Name:      stringPtr("orders-data"),
+				MountPath: "/var/lib/postgresql/data",
+			},
+		},
+		"File": {
+			Data: storage.CreateStorageDTO{
+				Type:      storage.File,
+				MountPath: "/etc/postgresql/conf.d/tuning.conf",
+				Content:   stringPtr("max_connections = 200"),
+			},
+		},
+		"Directory": {
+			Data: storage.CreateStorageDTO{
+				Type:        storage.File,
+				MountPath:   "/docker-entrypoint-initdb.d",
+				IsDirectory: boolPtr(true),
+			},
+		},
+		"VolumeWithoutName": {
+			Data: storage.CreateStorageDTO{
+				Type:      storage.Persistent,
+				MountPath: "/data",
+			},
+			Error: true,
+		},
+		"FileWithoutContent": {
+			Data: storage.CreateStorageDTO{
+				Type:      storage.File,
+				MountPath: "/etc/app.conf",
+			},
+			Error: true,
+		},
+		"UnknownType": {
+			Data: storage.CreateStorageDTO{
+				Type:      "bind",
+				MountPath: "/data",
+			},
+			Error: true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fake := setup(t)
+			ctx := context.Background()
+			coolify := sdk.Init(host, apiKey)
+			uuid := fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", sdk.Database{Name: "orders"})
+
+			storageUUID, errors := coolify.Database.Storages(uuid).Create(ctx, &testComponent.Data)
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+			if testComponent.Error {
+				return
+			}
+
+			storages, err := coolify.Database.Storages(uuid).List(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(*storages) != 1 {
+				t.Fatalf("got %d storages, want 1", len(*storages))
+			}
+			got := (*storages)[0]
+			if got.UUID != *storageUUID || got.Type != testComponent.Data.Type || got.MountPath != testComponent.Data.MountPath {
+				t.Errorf("got %+v, want %+v", got, testComponent.Data)
+			}
+		})
+	}
+}
+
+func TestUpdateAndDeleteStorage(t *testing.T) {
+	fake := setup(t)
+	ctx := context.Background()
+	coolify := sdk.Init(host, apiKey)
+	uuid := fake.AddDatabase("ykwgwcg0cgk8owsk4gg8wwo4", sdk.Database{Name: "orders"})
+	storages := coolify.Storages(storage.Databases, uuid)
+
+	storageUUID, err := storages.Create(ctx, &storage.CreateStorageDTO{
+		Type:      storage.Persistent,
+		Name:      stringPtr("orders-data"),
+		MountPath: "/data",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storages.Update(ctx, *storageUUID, &storage.UpdateStorageDTO{MountPath: stringPtr("/var/lib/data")}); err != nil {
+		t.Fatal(err)
+	}
+	list, err := storages.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*list)[0].MountPath != "/var/lib/data" || (*list)[0].Name != "orders-data" {
+		t.Errorf("got %+v after update", (*list)[0])
+	}
+
+	if err := storages.Delete(ctx, *storageUUID); err != nil {
+		t.Fatal(err)
+	}
+	list, err = storages.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*list) != 0 {
+		t.Errorf("got %d storages after delete, want 0", len(*list))
+	}
+
+	if err := storages.Delete(ctx, *storageUUID); err == nil {
+		t.Error("expected an error deleting a missing storage")
+	}
+	if _, err := coolify.Storages(storage.Databases, "").List(ctx); err == nil {
+		t.Error("expected an error without a UUID")
+	}
+}
@@ -0,0 +1,173 @@
+package coolify_sdk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/marconneves/coolify-sdk-go"
+	"github.com/marconneves/coolify-sdk-go/client"
+	"github.com/marconneves/coolify-sdk-go/coolifytest"
+)
+
+func TestParseVersion(t *testing.T) {
+	cases := map[string]struct {
+		Version string
+		Other   string
+		Compare int
+		Error   bool
+	}{
+		"Equal": {
+			Version: "4.0.0-beta.360",
+			Other:   "v4.0.0-beta.360",
+			Compare: 0,
+		},
+		"NumericPrerelease": {
+			Version: "4.0.0-beta.99",
+			Other:   "4.0.0-beta.360",
+			Compare: -1,
+		},
+		"ReleaseAfterPrerelease": {
+			Version: "4.0.0",
+			Other:   "4.0.0-beta.460",
+			Compare: 1,
+		},
+		"Minor": {
+			Version: "4.1.0-beta.1",
+			Other:   "4.0.9",
+			Compare: 1,
+		},
+		"ShortForm": {
+			Version: "4",
+			Other:   "4.0.0",
+			Compare: 0,
+		},
+		"TrailingNewline": {
+			Version: "4.0.0-beta.420\n",
+			Other:   "4.0.0-beta.420",
+			Compare: 0,
+		},
+		"Invalid": {
+			Version: "latest",
+			Error:   true,
+		},
+	}
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			version, errors := client.ParseVersion(testComponent.Version)
+
+			if errors != nil && !testComponent.Error {
+				t.Fatalf("Error: %v", errors)
+			}
+			if errors == nil && testComponent.Error {
+				t.Fatalf("expected an error")
+			}
+			if testComponent.Error {
+				return
+			}
+
+			other, err := client.ParseVersion(testComponent.Other)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c := version.Compare(other); c != testComponent.Compare {
+				t.Errorf("%s compared to %s = %d, want %d", version, other, c, testComponent.Compare)
+			}
+		})
+	}
+}
+
+func TestVersion(t *testing.T) {
+	cases := map[string]struct {
+		Version     string
+		Require     string
+		Unsupported bool
+	}{
+		"Current": {
+			Version: coolifytest.DefaultVersion,
+			Require: "4.0.0-beta.380",
+		},
+		"TooOld": {
+			Version:     "4.0.0-beta.300",
+			Require:     "4.0.0-beta.380",
+			Unsupported: true,
+		},
+	}
+
+	capability := client.Capability("test_capability")
+	if err := client.RegisterCapability(capability, "4.0.0-beta.380"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.UnregisterCapability(capability) })
+
+	for testName, testComponent := range cases {
+		t.Run(testName, func(t *testing.T) {
+			fake := setup(t)
+			fake.Version = testComponent.Version
+			ctx := context.Background()
+			coolify := sdk.Init(host, apiKey)
+
+			version, err := coolify.Version(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version.String() != testComponent.Version {
+				t.Errorf("got version %s, want %s", version, testComponent.Version)
+			}
+
+			errors := coolify.RequireVersion(ctx, testComponent.Require)
+			if isError(errors, sdk.ErrUnsupportedByServer) != testComponent.Unsupported {
+				t.Errorf("RequireVersion returned %v", errors)
+			}
+
+			supported, err := coolify.Supports(ctx, capability)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if supported == testComponent.Unsupported {
+				t.Errorf("Supports returned %v", supported)
+			}
+		})
+	}
+}
+
+func TestVersionUnauthorized(t *testing.T) {
+	setup(t)
+
+	_, err := sdk.Init(host, "wrong-token").Version(context.Background())
+	if err == nil {
+		t.Error("expected an error with an invalid token")
+	}
+}
+
+func TestVersionSlowServer(t *testing.T) {
+	fake := setup(t)
+	fake.Inject(coolifytest.Fault{Path: "version", Latency: 500 * time.Millisecond, Times: 1})
+	coolify := sdk.Init(host, apiKey)
+
+	first := make(chan error, 1)
+	go func() {
+		_, err := coolify.Version(context.Background())
+		first <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := coolify.Version(ctx)
+	if !isError(err, context.DeadlineExceeded) {
+		t.Errorf("Version returned %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 250*time.Millisecond {
+		t.Errorf("Version waited %v for the request started by another caller", elapsed)
+	}
+
+	if err := <-first; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := coolify.Version(context.Background()); err != nil {
+		t.Error(err)
+	}
+}
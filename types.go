@@ -1,22 +1,46 @@
-package coolify_sdk
-
-import (
-	database "github.com/marconneves/coolify-sdk-go/database"
-	server "github.com/marconneves/coolify-sdk-go/server"
-)
-
-type CreateServerDTO = server.CreateServerDTO
-type CreateServerResponse = server.CreateServerResponse
-type UpdateServerDTO = server.UpdateServerDTO
-type Server = server.Server
-type Proxy = server.Proxy
-type Settings = server.Settings
-type Resource = server.Resource
-
-type UpdateDatabaseDTO = database.UpdateDatabaseDTO
-type CreateDatabasePostgresDTO = database.CreateDatabasePostgresDTO
-type CreateDatabasePostgresResponse = database.CreateDatabasePostgresResponse
-type CreateDatabaseRedisDTO = database.CreateDatabaseRedisDTO
-type CreateDatabaseRedisResponse = database.CreateDatabaseRedisResponse
-type Database = database.Database
-type Destination = database.Destination
+package coolify_sdk
+
+import (
+	client "github.com/marconneves/coolify-sdk-go/client"
+	database "github.com/marconneves/coolify-sdk-go/database"
+	destination "github.com/marconneves/coolify-sdk-go/destination"
+	server "github.com/marconneves/coolify-sdk-go/server"
+	storage "github.com/marconneves/coolify-sdk-go/storage"
+)
+
+type CreateServerDTO = server.CreateServerDTO
+type CreateServerResponse = server.CreateServerResponse
+type UpdateServerDTO = server.UpdateServerDTO
+type Server = server.Server
+type Proxy = server.Proxy
+type Settings = server.Settings
+type Resource = server.Resource
+
+type UpdateDatabaseDTO = database.UpdateDatabaseDTO
+type CreateDatabasePostgresDTO = database.CreateDatabasePostgresDTO
+type CreateDatabasePostgresResponse = database.CreateDatabasePostgresResponse
+type CreateDatabaseRedisDTO = database.CreateDatabaseRedisDTO
+type CreateDatabaseRedisResponse = database.CreateDatabaseRedisResponse
+type CreateDatabaseDTO = database.CreateDatabaseDTO
+type Database = database.Database
+type DatabaseFilter = database.Filter
+type DatabaseStatus = database.Status
+type WaitOptions = database.WaitOptions
+type Tag = database.Tag
+
+type Destination = destination.Destination
+type DestinationType = destination.Type
+type CreateDestinationDTO = destination.CreateDestinationDTO
+
+type Storage = storage.Storage
+type StorageType = storage.Type
+type StorageResource = storage.Resource
+type CreateStorageDTO = storage.CreateStorageDTO
+type UpdateStorageDTO = storage.UpdateStorageDTO
+type S3Storage = storage.S3Storage
+type CreateS3StorageDTO = storage.CreateS3StorageDTO
+type UpdateS3StorageDTO = storage.UpdateS3StorageDTO
+
+type Timestamp = client.Timestamp
+type Version = client.Version
+type Capability = client.Capability
@@ -0,0 +1,37 @@
+package coolify_sdk
+
+import (
+	"context"
+	"errors"
+
+	client "github.com/marconneves/coolify-sdk-go/client"
+)
+
+// ErrUnsupportedByServer is returned when the Coolify instance is too old
+// for a feature.
+var ErrUnsupportedByServer = client.ErrUnsupportedByServer
+
+// Version returns the version of the Coolify instance. It is fetched once
+// per Sdk.
+func (c *Sdk) Version(ctx context.Context) (*Version, error) {
+	version, err := c.Client.ServerVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+// RequireVersion returns an error wrapping ErrUnsupportedByServer when the
+// instance is older than min, such as "4.0.0-beta.380".
+func (c *Sdk) RequireVersion(ctx context.Context, min string) error {
+	return c.Client.RequireVersion(ctx, min)
+}
+
+// Supports reports whether the instance is recent enough for capability.
+func (c *Sdk) Supports(ctx context.Context, capability Capability) (bool, error) {
+	err := c.Client.Require(ctx, capability)
+	if errors.Is(err, ErrUnsupportedByServer) {
+		return false, nil
+	}
+	return err == nil, err
+}